@@ -0,0 +1,57 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/madsbv/pokerepl/internal/pokeapi"
+)
+
+func commandAbility(ctx context.Context, c *config, args []string) {
+	if len(args) == 0 {
+		fmt.Println("Enter the name of an ability to look it up")
+		return
+	}
+	name := c.resolveName(ctx, "ability", args[0])
+
+	ability, err := c.client.GetAbility(ctx, name)
+	if errors.Is(err, pokeapi.ErrNotFound) {
+		fmt.Printf("There is no ability called %v\n", name)
+		return
+	} else if err != nil {
+		fmt.Printf("Something went wrong while looking up %v: %v\n", name, err)
+		return
+	}
+
+	fmt.Printf("Name: %v\n", c.localize("ability", ability.Name, ability.Names))
+	fmt.Printf("Introduced in: %v\n", generationName(ctx, c, ability.Generation.Name))
+	if effect := abilityEffect(ability, c.lang, false); effect != "" {
+		fmt.Printf("Effect: %v\n", effect)
+	}
+	fmt.Println("Pokemon with this ability:")
+	for _, p := range ability.Pokemon {
+		if p.IsHidden {
+			fmt.Printf("  - %v (hidden)\n", c.knownName("pokemon-species", p.Pokemon.Name))
+		} else {
+			fmt.Printf("  - %v\n", c.knownName("pokemon-species", p.Pokemon.Name))
+		}
+	}
+}
+
+// abilityEffect returns the effect text of ability in lang, falling back to fallbackLang.
+// If short is true, it returns the one sentence summary instead of the full text.
+func abilityEffect(ability pokeapi.PokeapiAbility, lang string, short bool) string {
+	for _, l := range []string{lang, fallbackLang} {
+		for _, e := range ability.EffectEntries {
+			if e.Language.Name != l {
+				continue
+			}
+			if short {
+				return e.ShortEffect
+			}
+			return cleanFlavorText(e.Effect)
+		}
+	}
+	return ""
+}
@@ -0,0 +1,41 @@
+package main
+
+import (
+	"fmt"
+	"strings"
+)
+
+// parseFlags splits the arguments of a command into positional arguments and
+// flags of the form --name value. Only the flags in allowed are accepted.
+func parseFlags(args []string, allowed ...string) (positional []string, flags map[string]string, err error) {
+	// Commands are split on single spaces, so repeated spaces leave empty arguments
+	var words []string
+	for _, arg := range args {
+		if arg != "" {
+			words = append(words, arg)
+		}
+	}
+
+	flags = make(map[string]string)
+	for i := 0; i < len(words); i++ {
+		arg := words[i]
+		name, isFlag := strings.CutPrefix(arg, "--")
+		if !isFlag {
+			positional = append(positional, arg)
+			continue
+		}
+		known := false
+		for _, a := range allowed {
+			known = known || a == name
+		}
+		if !known {
+			return nil, nil, fmt.Errorf("unknown flag --%v", name)
+		}
+		if i+1 >= len(words) {
+			return nil, nil, fmt.Errorf("flag --%v needs a value", name)
+		}
+		flags[name] = words[i+1]
+		i++
+	}
+	return positional, flags, nil
+}
@@ -0,0 +1,52 @@
+package main
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestParseFlags(t *testing.T) {
+	tests := []struct {
+		args           []string
+		wantPositional []string
+		wantFlags      map[string]string
+		wantErr        string
+	}{
+		{args: nil, wantFlags: map[string]string{}},
+		{args: []string{"pallet-town"}, wantPositional: []string{"pallet-town"}, wantFlags: map[string]string{}},
+		{
+			args:           []string{"pallet-town", "--version", "red", "--method", "walk"},
+			wantPositional: []string{"pallet-town"},
+			wantFlags:      map[string]string{"version": "red", "method": "walk"},
+		},
+		{
+			args:           []string{"--version", "red", "pallet-town"},
+			wantPositional: []string{"pallet-town"},
+			wantFlags:      map[string]string{"version": "red"},
+		},
+		// Repeated spaces leave empty arguments, which are skipped
+		{
+			args:           []string{"pallet-town", "", "--version", "", "red"},
+			wantPositional: []string{"pallet-town"},
+			wantFlags:      map[string]string{"version": "red"},
+		},
+		{
+			args:      []string{"--version", "red", "--version", "blue"},
+			wantFlags: map[string]string{"version": "blue"},
+		},
+		{args: []string{"pallet-town", "--limit", "5"}, wantErr: "unknown flag --limit"},
+		{args: []string{"pallet-town", "--version"}, wantErr: "flag --version needs a value"},
+	}
+	for _, tt := range tests {
+		positional, flags, err := parseFlags(tt.args, "version", "method")
+		if tt.wantErr != "" {
+			if err == nil || err.Error() != tt.wantErr {
+				t.Errorf(`parseFlags(%q) returned error %v, want %q.`, tt.args, err, tt.wantErr)
+			}
+			continue
+		}
+		if err != nil || !reflect.DeepEqual(positional, tt.wantPositional) || !reflect.DeepEqual(flags, tt.wantFlags) {
+			t.Errorf(`parseFlags(%q) = %q, %v, %v, want %q, %v, nil.`, tt.args, positional, flags, err, tt.wantPositional, tt.wantFlags)
+		}
+	}
+}
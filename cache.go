@@ -0,0 +1,61 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"time"
+)
+
+func commandCache(_ context.Context, c *config, args []string) {
+	if len(args) == 0 {
+		fmt.Println("Usage: cache stats|list|clear|evict <key>")
+		return
+	}
+
+	switch args[0] {
+	case "stats":
+		s := c.cache.Stats()
+		fmt.Printf("Hits: %v\n", s.Hits)
+		fmt.Printf("Misses: %v\n", s.Misses)
+		fmt.Printf("Evictions: %v\n", s.Evictions)
+		fmt.Printf("Entries: %v\n", s.Entries)
+		fmt.Printf("Size: %v\n", formatBytes(s.Bytes))
+	case "list":
+		now := time.Now()
+		for _, e := range c.cache.List() {
+			expiry := "expires in " + e.ExpiresAt.Sub(now).Round(time.Second).String()
+			if e.ExpiresAt.Before(now) {
+				expiry = "stale"
+			}
+			fmt.Printf(" - %v (%v, %v)\n", e.Key, formatBytes(e.Size), expiry)
+		}
+	case "clear":
+		c.cache.Clear()
+		fmt.Println("Cache cleared")
+	case "evict":
+		if len(args) < 2 {
+			fmt.Println("Enter the key of the cache entry to evict")
+			return
+		}
+		if c.cache.Evict(args[1]) {
+			fmt.Printf("Evicted %v\n", args[1])
+		} else {
+			fmt.Printf("%v is not cached\n", args[1])
+		}
+	default:
+		fmt.Printf("Unknown cache command %v\n", args[0])
+	}
+}
+
+func formatBytes(n int) string {
+	const unit = 1024
+	if n < unit {
+		return fmt.Sprintf("%d B", n)
+	}
+	div, exp := unit, 0
+	for m := n / unit; m >= unit; m /= unit {
+		div *= unit
+		exp++
+	}
+	return fmt.Sprintf("%.1f %ciB", float64(n)/float64(div), "KMGT"[exp])
+}
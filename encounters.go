@@ -0,0 +1,226 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"path"
+	"sort"
+	"strconv"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/madsbv/pokerepl/internal/pokeapi"
+)
+
+// An encounterSummary combines the encounter slots of a Pokemon that share a method and conditions.
+type encounterSummary struct {
+	method     string
+	conditions []string
+	minLevel   int
+	maxLevel   int
+	// Total chance of all the combined slots, in percent
+	chance int
+}
+
+// levels returns the level range of s, e.g. "levels 3-5".
+func (s encounterSummary) levels() string {
+	if s.minLevel == s.maxLevel {
+		return "level " + s.levelRange()
+	}
+	return "levels " + s.levelRange()
+}
+
+// levelRange returns the level range of s without a label, e.g. "3-5" or "4".
+func (s encounterSummary) levelRange() string {
+	if s.minLevel == s.maxLevel {
+		return strconv.Itoa(s.minLevel)
+	}
+	return fmt.Sprintf("%v-%v", s.minLevel, s.maxLevel)
+}
+
+// summarizeEncounters combines encounters by method and conditions, in the order they first appear.
+func summarizeEncounters(encounters []pokeapi.Encounter) []encounterSummary {
+	var summaries []encounterSummary
+	index := make(map[string]int)
+	for _, e := range encounters {
+		conditions := make([]string, 0, len(e.ConditionValues))
+		for _, c := range e.ConditionValues {
+			conditions = append(conditions, c.Name)
+		}
+		key := e.Method.Name + " " + strings.Join(conditions, ",")
+		i, ok := index[key]
+		if !ok {
+			i = len(summaries)
+			index[key] = i
+			summaries = append(summaries, encounterSummary{
+				method:     e.Method.Name,
+				conditions: conditions,
+				minLevel:   e.MinLevel,
+				maxLevel:   e.MaxLevel,
+			})
+		}
+		s := &summaries[i]
+		s.minLevel = min(s.minLevel, e.MinLevel)
+		s.maxLevel = max(s.maxLevel, e.MaxLevel)
+		s.chance += e.Chance
+	}
+	return summaries
+}
+
+// resourceID returns the numeric ID at the end of the URL of a resource, or -1 if there is none.
+// IDs follow the order of the games, so they are useful for sorting.
+func resourceID(url string) int {
+	id, err := strconv.Atoi(path.Base(url))
+	if err != nil {
+		return -1
+	}
+	return id
+}
+
+// A versionEncounter is an encounter in a location area in a specific version.
+type versionEncounter struct {
+	area      string
+	summaries []encounterSummary
+}
+
+func commandWhere(ctx context.Context, c *config, args []string) {
+	if len(args) == 0 {
+		fmt.Println("Enter the name of a Pokemon to see where to find it")
+		return
+	}
+	name := c.resolveName(ctx, "pokemon-species", args[0])
+
+	pokemon, err := lookupPokemon(ctx, c, name)
+	if errors.Is(err, pokeapi.ErrNotFound) {
+		fmt.Printf("There is no Pokemon called %v\n", name)
+		return
+	} else if err != nil {
+		fmt.Printf("Something went wrong while looking up %v: %v\n", name, err)
+		return
+	}
+	areas, err := c.client.GetPokemonEncounters(ctx, pokemon)
+	if err != nil {
+		fmt.Printf("Something went wrong while looking up where to find %v: %v\n", name, err)
+		return
+	}
+	if len(areas) == 0 {
+		fmt.Printf("%v can't be found in the wild\n", pokemonName(ctx, c, name))
+		return
+	}
+
+	byVersion := make(map[string][]versionEncounter)
+	versionIDs := make(map[string]int)
+	for _, area := range areas {
+		areaName := listedAreaName(c, area.LocationArea)
+		for _, v := range area.VersionDetails {
+			byVersion[v.Version.Name] = append(byVersion[v.Version.Name], versionEncounter{
+				areaName,
+				summarizeEncounters(v.EncounterDetails),
+			})
+			versionIDs[v.Version.Name] = resourceID(v.Version.URL)
+		}
+	}
+	versions := make([]string, 0, len(byVersion))
+	for v := range byVersion {
+		versions = append(versions, v)
+	}
+	sort.Slice(versions, func(i, j int) bool {
+		return versionIDs[versions[i]] < versionIDs[versions[j]]
+	})
+
+	for _, v := range versions {
+		fmt.Printf("%v:\n", v)
+		encounters := byVersion[v]
+		sort.Slice(encounters, func(i, j int) bool {
+			return encounters[i].area < encounters[j].area
+		})
+		for _, e := range encounters {
+			for _, s := range e.summaries {
+				line := fmt.Sprintf("  - %v: %v, %v, %v%%", e.area, s.method, s.levels(), s.chance)
+				if len(s.conditions) > 0 {
+					line += " (" + strings.Join(s.conditions, ", ") + ")"
+				}
+				fmt.Println(line)
+			}
+		}
+	}
+}
+
+func commandExplore(ctx context.Context, c *config, args []string) {
+	args, flags, err := parseFlags(args, "version", "method")
+	if err != nil {
+		fmt.Println(err)
+		return
+	}
+	if len(args) == 0 {
+		fmt.Println("Enter the name of an area to explore it further! Filter with --version <version> and --method <method>")
+		return
+	}
+
+	name := c.resolveName(ctx, "location-area", args[0])
+
+	areaDetails, err := c.client.GetLocationDetails(ctx, name)
+	if errors.Is(err, pokeapi.ErrNotFound) {
+		fmt.Printf("There is no area called %v\n", name)
+		return
+	} else if err != nil {
+		fmt.Printf("Something went wrong while exploring %v: %v\n", name, err)
+		return
+	}
+
+	version, ok := flags["version"]
+	if !ok {
+		version = latestVersion(areaDetails)
+	}
+
+	fmt.Printf("Exploring %v in %v...\n", c.localize("location-area", areaDetails.Name, areaDetails.Names), version)
+	var rates []string
+	for _, r := range areaDetails.EncounterMethodRates {
+		for _, v := range r.VersionDetails {
+			if v.Version.Name == version {
+				rates = append(rates, fmt.Sprintf("%v %v%%", r.EncounterMethod.Name, v.Rate))
+			}
+		}
+	}
+	if len(rates) > 0 {
+		fmt.Printf("Encounter rates: %v\n", strings.Join(rates, ", "))
+	}
+
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(w, "POKEMON\tMETHOD\tLEVELS\tCHANCE\tCONDITIONS")
+	found := false
+	for _, encounter := range areaDetails.PokemonEncounters {
+		for _, v := range encounter.VersionDetails {
+			if v.Version.Name != version {
+				continue
+			}
+			for _, s := range summarizeEncounters(v.EncounterDetails) {
+				if method, ok := flags["method"]; ok && s.method != method {
+					continue
+				}
+				fmt.Fprintf(w, "%v\t%v\t%v\t%v%%\t%v\n", c.knownName("pokemon-species", encounter.Pokemon.Name), s.method, s.levelRange(), s.chance, strings.Join(s.conditions, ", "))
+				found = true
+			}
+		}
+	}
+	if !found {
+		fmt.Println("No Pokemon found")
+		return
+	}
+	w.Flush()
+}
+
+// latestVersion returns the most recent version in which Pokemon can be encountered in area.
+func latestVersion(area pokeapi.PokeapiLocation) string {
+	latest, latestID := "", -1
+	for _, encounter := range area.PokemonEncounters {
+		for _, v := range encounter.VersionDetails {
+			if id := resourceID(v.Version.URL); id > latestID {
+				latest, latestID = v.Version.Name, id
+			}
+		}
+	}
+	return latest
+}
@@ -0,0 +1,69 @@
+package main
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/madsbv/pokerepl/internal/pokeapi"
+)
+
+func encounter(method string, minLevel, maxLevel, chance int, conditions ...string) pokeapi.Encounter {
+	e := pokeapi.Encounter{MinLevel: minLevel, MaxLevel: maxLevel, Chance: chance, Method: named[pokeapi.Resource](method)}
+	for _, c := range conditions {
+		e.ConditionValues = append(e.ConditionValues, named[pokeapi.Resource](c))
+	}
+	return e
+}
+
+func TestSummarizeEncounters(t *testing.T) {
+	tests := []struct {
+		encounters []pokeapi.Encounter
+		want       []encounterSummary
+	}{
+		{encounters: nil, want: nil},
+		{
+			encounters: []pokeapi.Encounter{encounter("walk", 3, 3, 20), encounter("walk", 5, 5, 10), encounter("walk", 2, 4, 15)},
+			want:       []encounterSummary{{method: "walk", conditions: []string{}, minLevel: 2, maxLevel: 5, chance: 45}},
+		},
+		// Methods and conditions are kept apart, in the order they first appear
+		{
+			encounters: []pokeapi.Encounter{
+				encounter("surf", 20, 30, 60),
+				encounter("walk", 3, 3, 20, "time-day"),
+				encounter("walk", 4, 4, 20, "time-night"),
+				encounter("surf", 25, 35, 30),
+				encounter("walk", 5, 5, 10, "time-day"),
+			},
+			want: []encounterSummary{
+				{method: "surf", conditions: []string{}, minLevel: 20, maxLevel: 35, chance: 90},
+				{method: "walk", conditions: []string{"time-day"}, minLevel: 3, maxLevel: 5, chance: 30},
+				{method: "walk", conditions: []string{"time-night"}, minLevel: 4, maxLevel: 4, chance: 20},
+			},
+		},
+	}
+	for _, tt := range tests {
+		if got := summarizeEncounters(tt.encounters); !reflect.DeepEqual(got, tt.want) {
+			t.Errorf(`summarizeEncounters(%+v) = %+v, want %+v.`, tt.encounters, got, tt.want)
+		}
+	}
+}
+
+func TestEncounterSummaryLevels(t *testing.T) {
+	tests := []struct {
+		minLevel, maxLevel int
+		wantLevels         string
+		wantRange          string
+	}{
+		{minLevel: 4, maxLevel: 4, wantLevels: "level 4", wantRange: "4"},
+		{minLevel: 3, maxLevel: 5, wantLevels: "levels 3-5", wantRange: "3-5"},
+	}
+	for _, tt := range tests {
+		s := encounterSummary{minLevel: tt.minLevel, maxLevel: tt.maxLevel}
+		if got := s.levels(); got != tt.wantLevels {
+			t.Errorf(`levels() = %q for levels %v to %v, want %q.`, got, tt.minLevel, tt.maxLevel, tt.wantLevels)
+		}
+		if got := s.levelRange(); got != tt.wantRange {
+			t.Errorf(`levelRange() = %q for levels %v to %v, want %q.`, got, tt.minLevel, tt.maxLevel, tt.wantRange)
+		}
+	}
+}
@@ -0,0 +1,129 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/madsbv/pokerepl/internal/pokeapi"
+)
+
+func commandEvolutions(ctx context.Context, c *config, args []string) {
+	if len(args) == 0 {
+		fmt.Println("Enter the name of a Pokemon to see its evolutions")
+		return
+	}
+	name := c.resolveName(ctx, "pokemon-species", args[0])
+
+	species, err := lookupSpecies(ctx, c, name)
+	if errors.Is(err, pokeapi.ErrNotFound) {
+		fmt.Printf("There is no Pokemon called %v\n", name)
+		return
+	} else if err != nil {
+		fmt.Printf("Something went wrong while looking up %v: %v\n", name, err)
+		return
+	}
+	chain, err := species.EvolutionChain.Resolve(ctx, c.client)
+	if err != nil {
+		fmt.Printf("Something went wrong while looking up the evolutions of %v: %v\n", name, err)
+		return
+	}
+
+	fmt.Println(listedSpeciesName(c, chain.Chain.Species))
+	printEvolutions(c, chain.Chain, "")
+}
+
+// printEvolutions prints the evolutions of link as a tree, with each line prefixed by indent.
+func printEvolutions(c *config, link pokeapi.ChainLink, indent string) {
+	for i, next := range link.EvolvesTo {
+		branch, childIndent := "├── ", "│   "
+		if i == len(link.EvolvesTo)-1 {
+			branch, childIndent = "└── ", "    "
+		}
+		fmt.Printf("%v%v%v", indent, branch, listedSpeciesName(c, next.Species))
+		if len(next.EvolutionDetails) > 0 {
+			methods := make([]string, 0, len(next.EvolutionDetails))
+			for _, d := range next.EvolutionDetails {
+				methods = append(methods, describeEvolution(d))
+			}
+			fmt.Printf(" (%v)", strings.Join(methods, " or "))
+		}
+		fmt.Println()
+		printEvolutions(c, next, indent+childIndent)
+	}
+}
+
+// describeEvolution summarizes the trigger and conditions of an evolution, e.g. "level 16" or "trade holding metal-coat".
+func describeEvolution(d pokeapi.EvolutionDetail) string {
+	var parts []string
+	switch d.Trigger.Name {
+	case "level-up":
+		if d.MinLevel > 0 {
+			parts = append(parts, fmt.Sprintf("level %v", d.MinLevel))
+		} else {
+			parts = append(parts, "level up")
+		}
+	case "use-item":
+		parts = append(parts, "use "+d.Item.Name)
+	default:
+		parts = append(parts, strings.ReplaceAll(d.Trigger.Name, "-", " "))
+	}
+
+	if d.HeldItem.Name != "" {
+		parts = append(parts, "holding "+d.HeldItem.Name)
+	}
+	if d.TradeSpecies.Name != "" {
+		parts = append(parts, "for "+d.TradeSpecies.Name)
+	}
+	if d.KnownMove.Name != "" {
+		parts = append(parts, "knowing "+d.KnownMove.Name)
+	}
+	if d.KnownMoveType.Name != "" {
+		parts = append(parts, "knowing a "+d.KnownMoveType.Name+" move")
+	}
+	if d.Location.Name != "" {
+		parts = append(parts, "at "+d.Location.Name)
+	}
+	if d.MinHappiness > 0 {
+		parts = append(parts, fmt.Sprintf("with happiness %v+", d.MinHappiness))
+	}
+	if d.MinBeauty > 0 {
+		parts = append(parts, fmt.Sprintf("with beauty %v+", d.MinBeauty))
+	}
+	if d.MinAffection > 0 {
+		parts = append(parts, fmt.Sprintf("with affection %v+", d.MinAffection))
+	}
+	if d.TimeOfDay != "" {
+		parts = append(parts, "during "+d.TimeOfDay)
+	}
+	switch d.Gender {
+	case 1:
+		parts = append(parts, "if female")
+	case 2:
+		parts = append(parts, "if male")
+	}
+	if d.PartySpecies.Name != "" {
+		parts = append(parts, "with "+d.PartySpecies.Name+" in the party")
+	}
+	if d.PartyType.Name != "" {
+		parts = append(parts, "with a "+d.PartyType.Name+" type in the party")
+	}
+	if d.RelativePhysicalStats != nil {
+		switch *d.RelativePhysicalStats {
+		case 1:
+			parts = append(parts, "if Attack > Defense")
+		case 0:
+			parts = append(parts, "if Attack = Defense")
+		case -1:
+			parts = append(parts, "if Attack < Defense")
+		}
+	}
+	if d.NeedsOverworldRain {
+		parts = append(parts, "while raining")
+	}
+	if d.TurnUpsideDown {
+		parts = append(parts, "holding the console upside down")
+	}
+	return strings.Join(parts, " ")
+}
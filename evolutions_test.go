@@ -0,0 +1,39 @@
+package main
+
+import (
+	"testing"
+
+	"github.com/madsbv/pokerepl/internal/pokeapi"
+)
+
+func named[T any](name string) pokeapi.NamedAPIResource[T] {
+	return pokeapi.NamedAPIResource[T]{Name: name}
+}
+
+func TestDescribeEvolution(t *testing.T) {
+	above, equal, below := 1, 0, -1
+	tests := []struct {
+		detail pokeapi.EvolutionDetail
+		want   string
+	}{
+		{pokeapi.EvolutionDetail{Trigger: named[pokeapi.Resource]("level-up"), MinLevel: 16}, "level 16"},
+		{pokeapi.EvolutionDetail{Trigger: named[pokeapi.Resource]("level-up"), MinHappiness: 220}, "level up with happiness 220+"},
+		{pokeapi.EvolutionDetail{Trigger: named[pokeapi.Resource]("use-item"), Item: named[pokeapi.PokeapiItem]("thunder-stone")}, "use thunder-stone"},
+		{pokeapi.EvolutionDetail{Trigger: named[pokeapi.Resource]("trade"), HeldItem: named[pokeapi.PokeapiItem]("metal-coat")}, "trade holding metal-coat"},
+		{pokeapi.EvolutionDetail{Trigger: named[pokeapi.Resource]("trade"), TradeSpecies: named[pokeapi.PokeapiSpecies]("shelmet")}, "trade for shelmet"},
+		{pokeapi.EvolutionDetail{Trigger: named[pokeapi.Resource]("level-up"), MinLevel: 20, Gender: 1}, "level 20 if female"},
+		{pokeapi.EvolutionDetail{Trigger: named[pokeapi.Resource]("level-up"), MinLevel: 20, Gender: 2}, "level 20 if male"},
+		{pokeapi.EvolutionDetail{Trigger: named[pokeapi.Resource]("level-up"), MinLevel: 20, RelativePhysicalStats: &above}, "level 20 if Attack > Defense"},
+		{pokeapi.EvolutionDetail{Trigger: named[pokeapi.Resource]("level-up"), MinLevel: 20, RelativePhysicalStats: &equal}, "level 20 if Attack = Defense"},
+		{pokeapi.EvolutionDetail{Trigger: named[pokeapi.Resource]("level-up"), MinLevel: 20, RelativePhysicalStats: &below}, "level 20 if Attack < Defense"},
+		{pokeapi.EvolutionDetail{Trigger: named[pokeapi.Resource]("level-up"), TimeOfDay: "night", KnownMoveType: named[pokeapi.PokeapiType]("fairy")}, "level up knowing a fairy move during night"},
+		{pokeapi.EvolutionDetail{Trigger: named[pokeapi.Resource]("level-up"), MinLevel: 30, TurnUpsideDown: true}, "level 30 holding the console upside down"},
+		{pokeapi.EvolutionDetail{Trigger: named[pokeapi.Resource]("shed")}, "shed"},
+		{pokeapi.EvolutionDetail{Trigger: named[pokeapi.Resource]("three-critical-hits")}, "three critical hits"},
+	}
+	for _, tt := range tests {
+		if got := describeEvolution(tt.detail); got != tt.want {
+			t.Errorf(`describeEvolution(%+v) = %q, want %q.`, tt.detail, got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,17 @@
+package pokeapi
+
+import "time"
+
+// A Cache stores raw pokeapi responses, keyed by URL.
+// Implementations must be safe for concurrent use, and may drop entries at any time.
+type Cache interface {
+	// Lookup returns the value stored under key. If the value has expired but is
+	// still available, stale is true and the caller should refresh the entry.
+	Lookup(key string) (val []byte, stale bool, ok bool)
+	// Peek is like Lookup, but does not count as a use of the entry, e.g. in hit and miss statistics.
+	// It may also return expired entries that Lookup no longer does, as stale, so that the
+	// client can still revalidate them instead of downloading them again.
+	Peek(key string) (val []byte, stale bool, ok bool)
+	// AddWithTTL stores val under key, to expire after ttl.
+	AddWithTTL(key string, val []byte, ttl time.Duration)
+}
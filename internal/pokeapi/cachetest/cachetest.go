@@ -0,0 +1,102 @@
+// Package cachetest provides a conformance test suite for implementations of pokeapi.Cache.
+package cachetest
+
+import (
+	"fmt"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/madsbv/pokerepl/internal/pokeapi"
+)
+
+// Options describes the guarantees a backend makes beyond the pokeapi.Cache contract.
+type Options struct {
+	// Lossy backends may forget entries immediately, like a no-op cache.
+	// Every backend must still never return a value that was not the last one stored under a key.
+	Lossy bool
+}
+
+// Run runs the conformance suite against caches created by newCache.
+// Every subtest gets a fresh cache.
+func Run(t *testing.T, newCache func(t *testing.T) pokeapi.Cache, opts Options) {
+	t.Run("Miss", func(t *testing.T) {
+		c := newCache(t)
+		if val, _, ok := c.Lookup("missing"); ok || val != nil {
+			t.Fatalf(`Lookup("missing") = %v, %v, want nil, false.`, val, ok)
+		}
+	})
+
+	t.Run("RoundTrip", func(t *testing.T) {
+		c := newCache(t)
+		c.AddWithTTL("a", []byte("value a"), time.Hour)
+		c.AddWithTTL("b", []byte("value b"), time.Hour)
+		for _, key := range []string{"a", "b"} {
+			checkFresh(t, c, key, "value "+key, opts)
+		}
+	})
+
+	t.Run("Peek", func(t *testing.T) {
+		c := newCache(t)
+		c.AddWithTTL("key", []byte("value"), time.Hour)
+		val, stale, ok := c.Peek("key")
+		if (ok || !opts.Lossy) && (!ok || stale || string(val) != "value") {
+			t.Fatalf(`Peek("key") = %q, %v, %v, want "value", false, true.`, val, stale, ok)
+		}
+		if val, _, ok := c.Peek("missing"); ok || val != nil {
+			t.Fatalf(`Peek("missing") = %v, %v, want nil, false.`, val, ok)
+		}
+	})
+
+	t.Run("Overwrite", func(t *testing.T) {
+		c := newCache(t)
+		c.AddWithTTL("key", []byte("old"), time.Hour)
+		c.AddWithTTL("key", []byte("new"), time.Hour)
+		checkFresh(t, c, "key", "new", opts)
+	})
+
+	t.Run("Expiry", func(t *testing.T) {
+		c := newCache(t)
+		c.AddWithTTL("key", []byte("value"), time.Millisecond)
+		time.Sleep(10 * time.Millisecond)
+		val, stale, ok := c.Lookup("key")
+		if ok && (!stale || string(val) != "value") {
+			t.Fatalf(`Lookup("key") = %q, %v, %v for an expired entry, want a miss or "value", true, true.`, val, stale, ok)
+		}
+		val, stale, ok = c.Peek("key")
+		if ok && (!stale || string(val) != "value") {
+			t.Fatalf(`Peek("key") = %q, %v, %v for an expired entry, want a miss or "value", true, true.`, val, stale, ok)
+		}
+	})
+
+	t.Run("Concurrent", func(t *testing.T) {
+		c := newCache(t)
+		var wg sync.WaitGroup
+		for i := range 8 {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				for j := range 100 {
+					key := fmt.Sprintf("key%d", j%10)
+					c.AddWithTTL(key, []byte(key), time.Hour)
+					if val, _, ok := c.Lookup(key); ok && string(val) != key {
+						t.Errorf(`goroutine %d: Lookup(%q) = %q, want %q.`, i, key, val, key)
+						return
+					}
+				}
+			}()
+		}
+		wg.Wait()
+	})
+}
+
+func checkFresh(t *testing.T, c pokeapi.Cache, key string, want string, opts Options) {
+	t.Helper()
+	val, stale, ok := c.Lookup(key)
+	if !ok && opts.Lossy {
+		return
+	}
+	if !ok || stale || string(val) != want {
+		t.Fatalf(`Lookup(%q) = %q, %v, %v, want %q, false, true.`, key, val, stale, ok, want)
+	}
+}
@@ -0,0 +1,208 @@
+package pokeapi
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+)
+
+// DefaultBaseURL is the public PokeAPI instance.
+const DefaultBaseURL = "https://pokeapi.co/api/v2/"
+
+// How long responses stay fresh in the cache. Resource lists grow whenever new
+// games come out, while the resources themselves practically never change.
+const (
+	listTTL     = 24 * time.Hour
+	resourceTTL = 7 * 24 * time.Hour
+)
+
+// A Client fetches resources from a PokeAPI instance, caching the responses.
+type Client struct {
+	baseURL    string
+	httpClient *http.Client
+	cache      Cache
+	// Timeout for each attempt at a request
+	timeout time.Duration
+	// How many times failed requests are retried
+	retries int
+	// Throttles requests, or nil for no limit
+	limiter *limiter
+	// Called when a request is delayed by the limiter
+	onDelay func(url string, d time.Duration)
+	// Requests currently in flight, so that concurrent requests for the same URL only hit the network once
+	inflight group
+}
+
+// NewClient creates a client for the public PokeAPI which caches responses in cache.
+func NewClient(cache Cache, opts ...Option) *Client {
+	c := &Client{
+		baseURL:    DefaultBaseURL,
+		httpClient: http.DefaultClient,
+		cache:      cache,
+		timeout:    10 * time.Second,
+		retries:    3,
+	}
+	for _, opt := range opts {
+		opt(c)
+	}
+	return c
+}
+
+// An Option configures a Client created by NewClient.
+type Option func(*Client)
+
+// WithBaseURL makes the client use the PokeAPI instance at baseURL, e.g. a local mirror.
+// baseURL should include the API version, like DefaultBaseURL.
+func WithBaseURL(baseURL string) Option {
+	return func(c *Client) {
+		if !strings.HasSuffix(baseURL, "/") {
+			baseURL += "/"
+		}
+		c.baseURL = baseURL
+	}
+}
+
+// WithHTTPClient makes the client send its requests through httpClient.
+func WithHTTPClient(httpClient *http.Client) Option {
+	return func(c *Client) {
+		c.httpClient = httpClient
+	}
+}
+
+// WithTimeout limits each attempt at a request to d.
+func WithTimeout(d time.Duration) Option {
+	return func(c *Client) {
+		c.timeout = d
+	}
+}
+
+// WithRetries makes the client retry requests that fail with transient errors up to n times.
+func WithRetries(n int) Option {
+	return func(c *Client) {
+		c.retries = n
+	}
+}
+
+// WithRateLimit limits the client to rate requests per second on average, with bursts of up to burst requests.
+// rate must be positive. Requests over the limit are delayed until they fit, and every attempt at a request counts.
+func WithRateLimit(rate float64, burst int) Option {
+	return func(c *Client) {
+		c.limiter = newLimiter(rate, burst)
+	}
+}
+
+// WithDelayNotifier makes the client call f whenever the request for url is delayed by d due to rate limiting.
+// f may be called from multiple goroutines, but not for background refreshes of stale responses.
+func WithDelayNotifier(f func(url string, d time.Duration)) Option {
+	return func(c *Client) {
+		c.onDelay = f
+	}
+}
+
+// endpointURL returns the URL of the resource query at endpoint, e.g. "pokemon/" and "pikachu".
+func (c *Client) endpointURL(endpoint string, query string) string {
+	return c.baseURL + endpoint + query
+}
+
+func getParsedResponse[T any](ctx context.Context, c *Client, query string, ttl time.Duration) (T, error) {
+	t := *new(T)
+
+	body, err := c.getPokeapiJSONResponse(ctx, query, ttl)
+	if err != nil {
+		return t, err
+	}
+
+	err = json.Unmarshal(body, &t)
+	return t, err
+}
+
+func (c *Client) getPokeapiJSONResponse(ctx context.Context, query string, ttl time.Duration) ([]byte, error) {
+	val, stale, exists := c.cache.Lookup(query)
+	if exists {
+		cached := decodeResponse(val)
+		if stale {
+			// Errors are ignored, since the caller is served the stale value anyway.
+			// The refresh must outlive ctx, which ends with the caller's request.
+			refreshCtx := context.WithValue(context.Background(), backgroundKey{}, true)
+			go c.inflight.do(refreshCtx, query, func(ctx context.Context) ([]byte, error) {
+				return c.fetch(ctx, query, ttl, &cached)
+			})
+		}
+		return cached.body, nil
+	}
+	body, err, _ := c.inflight.do(ctx, query, func(ctx context.Context) ([]byte, error) {
+		// An identical request may have completed between the lookup above and joining the group.
+		// Peeking keeps this second look from counting as another miss, and finds
+		// expired responses that can be revalidated rather than downloaded again.
+		val, stale, exists := c.cache.Peek(query)
+		if !exists {
+			return c.fetch(ctx, query, ttl, nil)
+		}
+		cached := decodeResponse(val)
+		if !stale {
+			return cached.body, nil
+		}
+		return c.fetch(ctx, query, ttl, &cached)
+	})
+	return body, err
+}
+
+// fetch requests query from the network, retrying transient failures, and caches the response.
+// If cached is not nil, the request is conditional, and cached is kept if it is still up to date.
+func (c *Client) fetch(ctx context.Context, query string, ttl time.Duration, cached *cachedResponse) ([]byte, error) {
+	var resp cachedResponse
+	var notModified bool
+	var err error
+	for attempt := 0; ; attempt++ {
+		resp, notModified, err = c.fetchOnce(ctx, query, cached)
+		if err == nil || attempt >= c.retries || !isTransient(ctx, err) {
+			break
+		}
+		if err := sleep(ctx, backoff(attempt)); err != nil {
+			return nil, err
+		}
+	}
+	if err != nil {
+		return nil, err
+	}
+	if notModified {
+		// Re-adding the entry extends its lifetime
+		resp = *cached
+	}
+	c.cache.AddWithTTL(query, resp.encode(), ttl)
+	return resp.body, nil
+}
+
+func (c *Client) fetchOnce(ctx context.Context, query string, cached *cachedResponse) (resp cachedResponse, notModified bool, err error) {
+	if err := c.wait(ctx, query); err != nil {
+		return resp, false, err
+	}
+	ctx, cancel := context.WithTimeout(ctx, c.timeout)
+	defer cancel()
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, query, nil)
+	if err != nil {
+		return resp, false, err
+	}
+	if cached != nil {
+		cached.setConditionalHeaders(req)
+	}
+	httpResp, err := c.httpClient.Do(req)
+	if err != nil {
+		return resp, false, err
+	}
+	defer httpResp.Body.Close()
+	if httpResp.StatusCode == http.StatusNotModified && cached != nil {
+		return resp, true, nil
+	}
+	// Error pages must not end up in the cache
+	if httpResp.StatusCode < 200 || httpResp.StatusCode > 299 {
+		return resp, false, &StatusError{query, httpResp.StatusCode}
+	}
+	resp.etag, resp.lastModified = responseValidators(httpResp)
+	resp.body, err = io.ReadAll(httpResp.Body)
+	return resp, false, err
+}
@@ -0,0 +1,370 @@
+package pokeapi
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"runtime"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/madsbv/pokerepl/internal/pokecache"
+)
+
+// newTestClient serves handler from an httptest server and returns a client using it as its base URL.
+func newTestClient(t *testing.T, handler http.Handler, opts ...Option) *Client {
+	t.Helper()
+	server := httptest.NewServer(handler)
+	t.Cleanup(server.Close)
+	cache := pokecache.New(time.Minute)
+	t.Cleanup(cache.Close)
+	opts = append([]Option{WithBaseURL(server.URL + "/api/v2"), WithHTTPClient(server.Client())}, opts...)
+	return NewClient(cache, opts...)
+}
+
+func TestClientBaseURL(t *testing.T) {
+	var requests atomic.Int32
+	c := newTestClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests.Add(1)
+		if r.URL.Path != "/api/v2/pokemon/pikachu" {
+			t.Errorf("Got request for %v, want /api/v2/pokemon/pikachu.", r.URL.Path)
+		}
+		w.Write([]byte(`{"name": "pikachu", "base_experience": 112}`))
+	}))
+
+	for range 2 {
+		p, err := c.GetPokemonDetails(context.Background(), "pikachu")
+		if err != nil {
+			t.Fatalf("GetPokemonDetails(\"pikachu\") failed: %v", err)
+		}
+		if p.Name != "pikachu" || p.BaseExperience != 112 {
+			t.Fatalf("GetPokemonDetails(\"pikachu\") = %+v, want pikachu with base experience 112.", p)
+		}
+	}
+	if n := requests.Load(); n != 1 {
+		t.Fatalf("Server got %v requests, but the second one should have been served from the cache.", n)
+	}
+}
+
+func TestClientNotFound(t *testing.T) {
+	var requests atomic.Int32
+	c := newTestClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests.Add(1)
+		http.Error(w, "Not Found", http.StatusNotFound)
+	}))
+
+	for range 2 {
+		_, err := c.GetPokemonDetails(context.Background(), "pikachuu")
+		if !errors.Is(err, ErrNotFound) {
+			t.Fatalf("GetPokemonDetails(\"pikachuu\") returned error %v, want ErrNotFound.", err)
+		}
+		var statusErr *StatusError
+		if !errors.As(err, &statusErr) || statusErr.StatusCode != http.StatusNotFound {
+			t.Fatalf("GetPokemonDetails(\"pikachuu\") returned error %v, want a StatusError with status 404.", err)
+		}
+	}
+	if n := requests.Load(); n != 2 {
+		t.Fatalf("Server got %v requests, want 2 since error responses must not be cached.", n)
+	}
+}
+
+func TestClientRetriesTransientErrors(t *testing.T) {
+	var requests atomic.Int32
+	c := newTestClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if requests.Add(1) == 1 {
+			http.Error(w, "Service Unavailable", http.StatusServiceUnavailable)
+			return
+		}
+		w.Write([]byte(`{"name": "pikachu"}`))
+	}))
+
+	p, err := c.GetPokemonDetails(context.Background(), "pikachu")
+	if err != nil || p.Name != "pikachu" {
+		t.Fatalf("GetPokemonDetails(\"pikachu\") = %+v, %v, want pikachu after a retry.", p, err)
+	}
+	if n := requests.Load(); n != 2 {
+		t.Fatalf("Server got %v requests, want 2.", n)
+	}
+}
+
+func TestClientTimeout(t *testing.T) {
+	release := make(chan struct{})
+	c := newTestClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		<-release
+	}), WithTimeout(10*time.Millisecond), WithRetries(0))
+	// Registered after the server's cleanup, so it runs first and unblocks the handler
+	t.Cleanup(func() { close(release) })
+
+	_, err := c.GetPokemonDetails(context.Background(), "pikachu")
+	if !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("GetPokemonDetails(\"pikachu\") returned error %v, want a timeout.", err)
+	}
+}
+
+func TestClientCancelSharedRequest(t *testing.T) {
+	release := make(chan struct{})
+	c := newTestClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		<-release
+		w.Write([]byte(`{"name": "a"}`))
+	}))
+	t.Cleanup(func() {
+		select {
+		case <-release:
+		default:
+			close(release)
+		}
+	})
+	url := c.endpointURL(pokemonEndpoint, "a")
+
+	first, cancel := context.WithCancel(context.Background())
+	firstErr := make(chan error)
+	go func() {
+		_, err := c.GetPokemonDetails(first, "a")
+		firstErr <- err
+	}()
+	for c.inflight.waiters(url) < 1 {
+		runtime.Gosched()
+	}
+	type result struct {
+		pokemon PokeapiPokemon
+		err     error
+	}
+	second := make(chan result)
+	go func() {
+		p, err := c.GetPokemonDetails(context.Background(), "a")
+		second <- result{p, err}
+	}()
+	for c.inflight.waiters(url) < 2 {
+		runtime.Gosched()
+	}
+
+	cancel()
+	if err := <-firstErr; !errors.Is(err, context.Canceled) {
+		t.Fatalf("The cancelled request returned error %v, want context.Canceled.", err)
+	}
+	close(release)
+	if r := <-second; r.err != nil || r.pokemon.Name != "a" {
+		t.Fatalf("The other request returned %+v, %v, want pokemon a.", r.pokemon, r.err)
+	}
+}
+
+func TestClientRateLimit(t *testing.T) {
+	var delays atomic.Int32
+	c := newTestClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`{}`))
+	}), WithRateLimit(10, 2), WithDelayNotifier(func(url string, d time.Duration) {
+		delays.Add(1)
+	}))
+
+	start := time.Now()
+	for _, name := range []string{"a", "b", "c", "d"} {
+		if _, err := c.GetPokemonDetails(context.Background(), name); err != nil {
+			t.Fatalf("GetPokemonDetails(%q) failed: %v", name, err)
+		}
+	}
+	// The burst covers two requests, and the other two wait up to 100ms each
+	if elapsed := time.Since(start); elapsed < 150*time.Millisecond {
+		t.Fatalf("4 requests took %v, but the rate limit should have made them take at least 150ms.", elapsed)
+	}
+	if n := delays.Load(); n != 2 {
+		t.Fatalf("Delay notifier was called %v times, want 2.", n)
+	}
+}
+
+func TestClientCacheStats(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`{"name": "pikachu"}`))
+	}))
+	defer server.Close()
+	cache := pokecache.New(time.Minute)
+	defer cache.Close()
+	c := NewClient(cache, WithBaseURL(server.URL), WithHTTPClient(server.Client()))
+
+	if _, err := c.GetPokemonDetails(context.Background(), "pikachu"); err != nil {
+		t.Fatalf("GetPokemonDetails(\"pikachu\") failed: %v", err)
+	}
+	if got := cache.Stats(); got.Hits != 0 || got.Misses != 1 {
+		t.Fatalf("Stats() = %+v after fetching once, want 0 hits and 1 miss.", got)
+	}
+	if _, err := c.GetPokemonDetails(context.Background(), "pikachu"); err != nil {
+		t.Fatalf("GetPokemonDetails(\"pikachu\") failed: %v", err)
+	}
+	if got := cache.Stats(); got.Hits != 1 || got.Misses != 1 {
+		t.Fatalf("Stats() = %+v after fetching twice, want 1 hit and 1 miss.", got)
+	}
+}
+
+// staleCache reports every entry as stale, and announces every addition on added.
+type staleCache struct {
+	mux     sync.Mutex
+	entries map[string][]byte
+	added   chan []byte
+}
+
+func (c *staleCache) Lookup(key string) ([]byte, bool, bool) {
+	c.mux.Lock()
+	defer c.mux.Unlock()
+	val, ok := c.entries[key]
+	return val, ok, ok
+}
+
+func (c *staleCache) Peek(key string) ([]byte, bool, bool) {
+	return c.Lookup(key)
+}
+
+func (c *staleCache) AddWithTTL(key string, val []byte, ttl time.Duration) {
+	c.mux.Lock()
+	c.entries[key] = val
+	c.mux.Unlock()
+	c.added <- val
+}
+
+func TestClientRevalidate(t *testing.T) {
+	var conditional atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("If-None-Match") == `"v1"` {
+			conditional.Add(1)
+			w.WriteHeader(http.StatusNotModified)
+			return
+		}
+		w.Header().Set("ETag", `"v1"`)
+		w.Write([]byte(`{"name": "pikachu"}`))
+	}))
+	defer server.Close()
+	cache := &staleCache{entries: make(map[string][]byte), added: make(chan []byte, 1)}
+	c := NewClient(cache, WithBaseURL(server.URL), WithHTTPClient(server.Client()))
+
+	if _, err := c.GetPokemonDetails(context.Background(), "pikachu"); err != nil {
+		t.Fatalf("GetPokemonDetails(\"pikachu\") failed: %v", err)
+	}
+	first := <-cache.added
+
+	// The entry is stale, so this serves it and revalidates it in the background
+	p, err := c.GetPokemonDetails(context.Background(), "pikachu")
+	if err != nil || p.Name != "pikachu" {
+		t.Fatalf("GetPokemonDetails(\"pikachu\") = %+v, %v, want the stale pikachu.", p, err)
+	}
+	second := <-cache.added
+
+	if n := conditional.Load(); n != 1 {
+		t.Fatalf("Server got %v conditional requests, want 1.", n)
+	}
+	if string(first) != string(second) {
+		t.Fatalf("Revalidated entry %q differs from the original %q.", second, first)
+	}
+}
+
+func TestClientRefreshDelayNotReported(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`{"name": "pikachu"}`))
+	}))
+	defer server.Close()
+	var delays atomic.Int32
+	cache := &staleCache{entries: make(map[string][]byte), added: make(chan []byte, 1)}
+	c := NewClient(cache, WithBaseURL(server.URL), WithHTTPClient(server.Client()),
+		WithRateLimit(10, 1), WithDelayNotifier(func(url string, d time.Duration) {
+			delays.Add(1)
+		}))
+
+	if _, err := c.GetPokemonDetails(context.Background(), "pikachu"); err != nil {
+		t.Fatalf("GetPokemonDetails(\"pikachu\") failed: %v", err)
+	}
+	<-cache.added
+	// The stale entry is refreshed in the background, which the limiter delays
+	if _, err := c.GetPokemonDetails(context.Background(), "pikachu"); err != nil {
+		t.Fatalf("GetPokemonDetails(\"pikachu\") failed: %v", err)
+	}
+	<-cache.added
+
+	if n := delays.Load(); n != 0 {
+		t.Fatalf("Delay notifier was called %v times for a background refresh, want 0.", n)
+	}
+}
+
+// testClock is a pokecache.Clock which only moves forward when told to, and never ticks.
+type testClock struct {
+	mux sync.Mutex
+	now time.Time
+}
+
+func (c *testClock) Now() time.Time {
+	c.mux.Lock()
+	defer c.mux.Unlock()
+	return c.now
+}
+
+func (c *testClock) Advance(d time.Duration) {
+	c.mux.Lock()
+	defer c.mux.Unlock()
+	c.now = c.now.Add(d)
+}
+
+func (c *testClock) NewTicker(d time.Duration) pokecache.Ticker {
+	return testTicker{}
+}
+
+type testTicker struct{}
+
+func (testTicker) C() <-chan time.Time { return nil }
+func (testTicker) Stop()               {}
+
+func TestClientRevalidateExpired(t *testing.T) {
+	var full, conditional atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("If-None-Match") == `"v1"` {
+			conditional.Add(1)
+			w.WriteHeader(http.StatusNotModified)
+			return
+		}
+		full.Add(1)
+		w.Header().Set("ETag", `"v1"`)
+		w.Write([]byte(`{"name": "pikachu"}`))
+	}))
+	defer server.Close()
+	clock := &testClock{now: time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)}
+	// Without a stale window, Lookup misses as soon as the entry expires
+	cache := pokecache.New(time.Hour, pokecache.WithClock(clock))
+	defer cache.Close()
+	c := NewClient(cache, WithBaseURL(server.URL), WithHTTPClient(server.Client()))
+
+	if _, err := c.GetPokemonDetails(context.Background(), "pikachu"); err != nil {
+		t.Fatalf("GetPokemonDetails(\"pikachu\") failed: %v", err)
+	}
+	clock.Advance(resourceTTL + time.Minute)
+	p, err := c.GetPokemonDetails(context.Background(), "pikachu")
+	if err != nil || p.Name != "pikachu" {
+		t.Fatalf("GetPokemonDetails(\"pikachu\") = %+v, %v after expiry, want pikachu.", p, err)
+	}
+
+	if n := full.Load(); n != 1 {
+		t.Fatalf("Server sent the full response %v times, want 1.", n)
+	}
+	if n := conditional.Load(); n != 1 {
+		t.Fatalf("Server got %v conditional requests, want 1.", n)
+	}
+	// The revalidated entry is fresh again
+	if _, stale, ok := cache.Lookup(c.endpointURL(pokemonEndpoint, "pikachu")); !ok || stale {
+		t.Fatalf("The revalidated entry should be fresh, got stale %v, ok %v.", stale, ok)
+	}
+}
+
+func TestClientPokemonEncounters(t *testing.T) {
+	c := newTestClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/api/v2/pokemon/386/encounters" {
+			http.NotFound(w, r)
+			return
+		}
+		w.Write([]byte(`[{"location_area": {"name": "birth-island-area"}}]`))
+	}))
+
+	for _, link := range []string{"/api/v2/pokemon/386/encounters", c.endpointURL(pokemonEndpoint, "386/encounters")} {
+		pokemon := PokeapiPokemon{Name: "deoxys-normal", LocationAreaEncounters: link}
+		areas, err := c.GetPokemonEncounters(context.Background(), pokemon)
+		if err != nil || len(areas) != 1 || areas[0].LocationArea.Name != "birth-island-area" {
+			t.Fatalf("GetPokemonEncounters() with link %q = %+v, %v, want birth-island-area.", link, areas, err)
+		}
+	}
+}
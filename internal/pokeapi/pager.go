@@ -0,0 +1,94 @@
+package pokeapi
+
+import (
+	"context"
+	"errors"
+	"fmt"
+)
+
+// ErrNoMorePages is returned by Pager when moving past either end of a list.
+var ErrNoMorePages = errors.New("no more pages")
+
+// A Pager walks the pages of a list endpoint of resources of type T. It is not safe for concurrent use.
+type Pager[T any] struct {
+	client *Client
+	// URL of the first page
+	first string
+	// Current page, if started
+	page    NamedAPIResourceList[T]
+	started bool
+}
+
+// List returns a Pager for the list endpoint of resource, e.g. "location-area" for PokeapiLocation.
+// Pages start at offset and have limit resources each, or PokeAPI's default of 20 if limit is 0.
+func List[T any](c *Client, resource string, limit int, offset int) *Pager[T] {
+	return &Pager[T]{client: c, first: c.listURL(resource, limit, offset)}
+}
+
+func (c *Client) listURL(resource string, limit int, offset int) string {
+	url := c.endpointURL(resource+"/", "")
+	if limit > 0 {
+		url += fmt.Sprintf("?offset=%d&limit=%d", offset, limit)
+	} else if offset > 0 {
+		url += fmt.Sprintf("?offset=%d", offset)
+	}
+	return url
+}
+
+// Next fetches the next page, or the first page if the pager has not fetched any yet.
+func (p *Pager[T]) Next(ctx context.Context) (NamedAPIResourceList[T], error) {
+	if !p.started {
+		return p.fetch(ctx, p.first)
+	}
+	if p.page.Next == nil {
+		return NamedAPIResourceList[T]{}, ErrNoMorePages
+	}
+	return p.fetch(ctx, *p.page.Next)
+}
+
+// Prev fetches the page before the current one.
+func (p *Pager[T]) Prev(ctx context.Context) (NamedAPIResourceList[T], error) {
+	if !p.started || p.page.Previous == nil {
+		return NamedAPIResourceList[T]{}, ErrNoMorePages
+	}
+	return p.fetch(ctx, *p.page.Previous)
+}
+
+// Page returns the page fetched last.
+func (p *Pager[T]) Page() NamedAPIResourceList[T] {
+	return p.page
+}
+
+// Reset makes the next call to Next fetch the first page again.
+func (p *Pager[T]) Reset() {
+	p.page = NamedAPIResourceList[T]{}
+	p.started = false
+}
+
+func (p *Pager[T]) fetch(ctx context.Context, url string) (NamedAPIResourceList[T], error) {
+	page, err := getParsedResponse[NamedAPIResourceList[T]](ctx, p.client, url, listTTL)
+	if err != nil {
+		return page, err
+	}
+	p.page = page
+	p.started = true
+	return page, nil
+}
+
+// FetchPage fetches the page of limit resources starting at offset from the list endpoint of resource,
+// for jumping straight to a page. A limit of 0 means PokeAPI's default of 20.
+func FetchPage[T any](ctx context.Context, c *Client, resource string, limit int, offset int) (NamedAPIResourceList[T], error) {
+	return getParsedResponse[NamedAPIResourceList[T]](ctx, c, c.listURL(resource, limit, offset), listTTL)
+}
+
+// ListAll fetches all resources at the list endpoint of resource, starting at offset.
+// It uses two requests at most, regardless of how many resources there are.
+func ListAll[T any](ctx context.Context, c *Client, resource string, offset int) ([]NamedAPIResource[T], error) {
+	first, err := getParsedResponse[NamedAPIResourceList[T]](ctx, c, c.listURL(resource, 0, offset), listTTL)
+	if err != nil || first.Next == nil {
+		return first.Results, err
+	}
+	// Now that the total is known, fetch everything in a single page
+	all, err := getParsedResponse[NamedAPIResourceList[T]](ctx, c, c.listURL(resource, first.Count, offset), listTTL)
+	return all.Results, err
+}
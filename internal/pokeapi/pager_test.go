@@ -0,0 +1,91 @@
+package pokeapi
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+	"testing"
+)
+
+// listHandler serves a list endpoint with n resources named item0, item1, ...
+func listHandler(t *testing.T, n int) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		offset, _ := strconv.Atoi(r.URL.Query().Get("offset"))
+		limit, err := strconv.Atoi(r.URL.Query().Get("limit"))
+		if err != nil {
+			limit = 20
+		}
+		page := NamedAPIResourceList[PokeapiItem]{Count: n}
+		for i := offset; i < min(offset+limit, n); i++ {
+			page.Results = append(page.Results, NamedAPIResource[PokeapiItem]{Name: fmt.Sprintf("item%d", i)})
+		}
+		pageURL := func(offset int) *string {
+			url := fmt.Sprintf("http://%s%s?offset=%d&limit=%d", r.Host, r.URL.Path, offset, limit)
+			return &url
+		}
+		if offset+limit < n {
+			page.Next = pageURL(offset + limit)
+		}
+		if offset > 0 {
+			page.Previous = pageURL(max(0, offset-limit))
+		}
+		if err := json.NewEncoder(w).Encode(page); err != nil {
+			t.Errorf("Failed to encode page: %v", err)
+		}
+	})
+}
+
+func TestPager(t *testing.T) {
+	c := newTestClient(t, listHandler(t, 5))
+	ctx := context.Background()
+	p := List[PokeapiItem](c, "item", 2, 0)
+
+	var names []string
+	for {
+		page, err := p.Next(ctx)
+		if errors.Is(err, ErrNoMorePages) {
+			break
+		} else if err != nil {
+			t.Fatalf("Next() failed: %v", err)
+		}
+		for _, r := range page.Results {
+			names = append(names, r.Name)
+		}
+	}
+	if fmt.Sprint(names) != "[item0 item1 item2 item3 item4]" {
+		t.Fatalf("Pager visited %v, want item0 through item4.", names)
+	}
+
+	page, err := p.Prev(ctx)
+	if err != nil || len(page.Results) != 2 || page.Results[0].Name != "item2" {
+		t.Fatalf("Prev() = %+v, %v, want the page starting at item2.", page, err)
+	}
+}
+
+func TestListAll(t *testing.T) {
+	c := newTestClient(t, listHandler(t, 45))
+	all, err := ListAll[PokeapiItem](context.Background(), c, "item", 5)
+	if err != nil {
+		t.Fatalf("ListAll() failed: %v", err)
+	}
+	if len(all) != 40 {
+		t.Fatalf("ListAll() returned %v resources, want 40.", len(all))
+	}
+	if all[0].Name != "item5" || all[39].Name != "item44" {
+		t.Fatalf("ListAll() returned %v to %v, want item5 through item44.", all[0].Name, all[39].Name)
+	}
+}
+
+func TestFetchPage(t *testing.T) {
+	c := newTestClient(t, listHandler(t, 45))
+	page, err := FetchPage[PokeapiItem](context.Background(), c, "item", 10, 40)
+	if err != nil {
+		t.Fatalf("FetchPage() failed: %v", err)
+	}
+	if page.Count != 45 || len(page.Results) != 5 || page.Results[0].Name != "item40" || page.Next != nil {
+		t.Fatalf("FetchPage() = %+v, want the last 5 of 45 items.", page)
+	}
+}
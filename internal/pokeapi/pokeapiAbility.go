@@ -0,0 +1,10 @@
+package pokeapi
+
+import "context"
+
+const abilityEndpoint = "ability/"
+
+func (c *Client) GetAbility(ctx context.Context, query string) (PokeapiAbility, error) {
+	url := c.endpointURL(abilityEndpoint, query)
+	return getParsedResponse[PokeapiAbility](ctx, c, url, resourceTTL)
+}
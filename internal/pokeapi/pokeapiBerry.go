@@ -0,0 +1,18 @@
+package pokeapi
+
+import "context"
+
+const (
+	berryEndpoint       = "berry/"
+	berryFlavorEndpoint = "berry-flavor/"
+)
+
+func (c *Client) GetBerry(ctx context.Context, query string) (PokeapiBerry, error) {
+	url := c.endpointURL(berryEndpoint, query)
+	return getParsedResponse[PokeapiBerry](ctx, c, url, resourceTTL)
+}
+
+func (c *Client) GetBerryFlavor(ctx context.Context, query string) (PokeapiBerryFlavor, error) {
+	url := c.endpointURL(berryFlavorEndpoint, query)
+	return getParsedResponse[PokeapiBerryFlavor](ctx, c, url, resourceTTL)
+}
@@ -0,0 +1,11 @@
+package pokeapi
+
+import "context"
+
+const evolutionChainEndpoint = "evolution-chain/"
+
+// GetEvolutionChain fetches the evolution chain with the given ID. Species link to their chain through EvolutionChain.
+func (c *Client) GetEvolutionChain(ctx context.Context, query string) (PokeapiEvolutionChain, error) {
+	url := c.endpointURL(evolutionChainEndpoint, query)
+	return getParsedResponse[PokeapiEvolutionChain](ctx, c, url, resourceTTL)
+}
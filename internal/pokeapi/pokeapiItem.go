@@ -0,0 +1,18 @@
+package pokeapi
+
+import "context"
+
+const (
+	itemEndpoint         = "item/"
+	itemCategoryEndpoint = "item-category/"
+)
+
+func (c *Client) GetItem(ctx context.Context, query string) (PokeapiItem, error) {
+	url := c.endpointURL(itemEndpoint, query)
+	return getParsedResponse[PokeapiItem](ctx, c, url, resourceTTL)
+}
+
+func (c *Client) GetItemCategory(ctx context.Context, query string) (PokeapiItemCategory, error) {
+	url := c.endpointURL(itemCategoryEndpoint, query)
+	return getParsedResponse[PokeapiItemCategory](ctx, c, url, resourceTTL)
+}
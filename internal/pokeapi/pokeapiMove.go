@@ -0,0 +1,10 @@
+package pokeapi
+
+import "context"
+
+const moveEndpoint = "move/"
+
+func (c *Client) GetMove(ctx context.Context, query string) (PokeapiMove, error) {
+	url := c.endpointURL(moveEndpoint, query)
+	return getParsedResponse[PokeapiMove](ctx, c, url, resourceTTL)
+}
@@ -1,17 +1,28 @@
 package pokeapi
 
 import (
-	"fmt"
-	"github.com/madsbv/pokerepl/internal/pokecache"
+	"context"
+	"net/url"
 )
 
-func GetPokemonDetails(query string, cache pokecache.Cache) (PokeapiPokemon, error) {
-	url := pokemonURL(query)
-	return getParsedResponse[PokeapiPokemon](url, cache)
+func (c *Client) GetPokemonDetails(ctx context.Context, query string) (PokeapiPokemon, error) {
+	url := c.endpointURL(pokemonEndpoint, query)
+	return getParsedResponse[PokeapiPokemon](ctx, c, url, resourceTTL)
 }
 
-var pokeapiPokemonURL string = "https://pokeapi.co/api/v2/pokemon/"
-
-func pokemonURL(query string) string {
-	return fmt.Sprintf("%s%s", pokeapiPokemonURL, query)
+// GetPokemonEncounters fetches the location areas where pokemon can be encountered,
+// by following its LocationAreaEncounters link.
+func (c *Client) GetPokemonEncounters(ctx context.Context, pokemon PokeapiPokemon) ([]LocationAreaEncounter, error) {
+	base, err := url.Parse(c.baseURL)
+	if err != nil {
+		return nil, err
+	}
+	// Older PokeAPI versions link to the encounters with a path relative to the host
+	link, err := url.Parse(pokemon.LocationAreaEncounters)
+	if err != nil {
+		return nil, err
+	}
+	return getParsedResponse[[]LocationAreaEncounter](ctx, c, base.ResolveReference(link).String(), resourceTTL)
 }
+
+const pokemonEndpoint = "pokemon/"
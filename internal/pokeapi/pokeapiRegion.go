@@ -0,0 +1,24 @@
+package pokeapi
+
+import "context"
+
+const (
+	regionEndpoint     = "region/"
+	placeEndpoint      = "location/"
+	generationEndpoint = "generation/"
+)
+
+func (c *Client) GetRegion(ctx context.Context, query string) (PokeapiRegion, error) {
+	url := c.endpointURL(regionEndpoint, query)
+	return getParsedResponse[PokeapiRegion](ctx, c, url, resourceTTL)
+}
+
+func (c *Client) GetPlace(ctx context.Context, query string) (PokeapiPlace, error) {
+	url := c.endpointURL(placeEndpoint, query)
+	return getParsedResponse[PokeapiPlace](ctx, c, url, resourceTTL)
+}
+
+func (c *Client) GetGeneration(ctx context.Context, query string) (PokeapiGeneration, error) {
+	url := c.endpointURL(generationEndpoint, query)
+	return getParsedResponse[PokeapiGeneration](ctx, c, url, resourceTTL)
+}
@@ -0,0 +1,10 @@
+package pokeapi
+
+import "context"
+
+const speciesEndpoint = "pokemon-species/"
+
+func (c *Client) GetSpecies(ctx context.Context, query string) (PokeapiSpecies, error) {
+	url := c.endpointURL(speciesEndpoint, query)
+	return getParsedResponse[PokeapiSpecies](ctx, c, url, resourceTTL)
+}
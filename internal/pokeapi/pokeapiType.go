@@ -0,0 +1,10 @@
+package pokeapi
+
+import "context"
+
+const typeEndpoint = "type/"
+
+func (c *Client) GetType(ctx context.Context, query string) (PokeapiType, error) {
+	url := c.endpointURL(typeEndpoint, query)
+	return getParsedResponse[PokeapiType](ctx, c, url, resourceTTL)
+}
@@ -0,0 +1,55 @@
+package pokeapi
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+// A limiter is a token bucket: it holds up to burst tokens, refilled at rate
+// tokens per second, and every request takes a token.
+type limiter struct {
+	mux    sync.Mutex
+	rate   float64
+	burst  float64
+	tokens float64
+	last   time.Time
+}
+
+func newLimiter(rate float64, burst int) *limiter {
+	return &limiter{rate: rate, burst: float64(burst), tokens: float64(burst), last: time.Now()}
+}
+
+// reserve takes a token, and returns how long the caller has to wait before the token is valid.
+func (l *limiter) reserve(now time.Time) time.Duration {
+	l.mux.Lock()
+	defer l.mux.Unlock()
+
+	l.tokens = min(l.burst, l.tokens+now.Sub(l.last).Seconds()*l.rate)
+	l.last = now
+	l.tokens--
+	if l.tokens >= 0 {
+		return 0
+	}
+	// Tokens can go negative, which queues up callers behind each other
+	return time.Duration(-l.tokens / l.rate * float64(time.Second))
+}
+
+// wait blocks until the client may send a request for query, or ctx is done.
+func (c *Client) wait(ctx context.Context, query string) error {
+	if c.limiter == nil {
+		return nil
+	}
+	d := c.limiter.reserve(time.Now())
+	if d <= 0 {
+		return nil
+	}
+	// Nobody is waiting for background requests, so their delays aren't worth reporting
+	if c.onDelay != nil && ctx.Value(backgroundKey{}) == nil {
+		c.onDelay(query, d)
+	}
+	return sleep(ctx, d)
+}
+
+// backgroundKey marks the contexts of requests that no caller is waiting for.
+type backgroundKey struct{}
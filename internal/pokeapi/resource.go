@@ -0,0 +1,62 @@
+package pokeapi
+
+import (
+	"context"
+	"encoding/json"
+)
+
+// A NamedAPIResource links to another resource of type T, which Resolve fetches.
+type NamedAPIResource[T any] struct {
+	Name string `json:"name"`
+	URL  string `json:"url"`
+}
+
+// Resolve fetches the linked resource through c, using c's cache.
+func (r NamedAPIResource[T]) Resolve(ctx context.Context, c *Client) (T, error) {
+	return getParsedResponse[T](ctx, c, r.URL, resourceTTL)
+}
+
+// Cached returns the linked resource if c has it cached, even if it has expired, without fetching it.
+// It is meant for resources that are nice to have, but not worth a request each, like names in a long list.
+func (r NamedAPIResource[T]) Cached(c *Client) (T, bool) {
+	t := *new(T)
+	val, _, ok := c.cache.Peek(r.URL)
+	if !ok {
+		return t, false
+	}
+	if err := json.Unmarshal(decodeResponse(val).body, &t); err != nil {
+		return t, false
+	}
+	return t, true
+}
+
+// An APIResource links to another resource of type T, like a NamedAPIResource,
+// for resources that don't have names.
+type APIResource[T any] struct {
+	URL string `json:"url"`
+}
+
+// Resolve fetches the linked resource through c, using c's cache.
+func (r APIResource[T]) Resolve(ctx context.Context, c *Client) (T, error) {
+	return getParsedResponse[T](ctx, c, r.URL, resourceTTL)
+}
+
+// Resource stands in for the types of resources this package has no struct for.
+// Resolving a NamedAPIResource[Resource] decodes the resource generically.
+type Resource = map[string]any
+
+// A Name is the name of a resource in a language.
+type Name struct {
+	Name     string                     `json:"name"`
+	Language NamedAPIResource[Resource] `json:"language"`
+}
+
+// A NamedAPIResourceList is a page of the resources available at a list endpoint, like /location-area/.
+type NamedAPIResourceList[T any] struct {
+	// Total number of resources at the endpoint
+	Count int `json:"count"`
+	// URLs of the adjacent pages, nil at either end
+	Next     *string               `json:"next"`
+	Previous *string               `json:"previous"`
+	Results  []NamedAPIResource[T] `json:"results"`
+}
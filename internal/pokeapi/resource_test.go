@@ -0,0 +1,48 @@
+package pokeapi
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+)
+
+func TestNamedAPIResourceResolve(t *testing.T) {
+	c := newTestClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/api/v2/pokemon/pikachu":
+			fmt.Fprintf(w, `{"name": "pikachu", "species": {"name": "pikachu", "url": "http://%s/api/v2/pokemon-species/25/"}}`, r.Host)
+		case "/api/v2/pokemon-species/25/":
+			w.Write([]byte(`{"name": "pikachu", "capture_rate": 190}`))
+		default:
+			http.NotFound(w, r)
+		}
+	}))
+	ctx := context.Background()
+
+	pokemon, err := c.GetPokemonDetails(ctx, "pikachu")
+	if err != nil {
+		t.Fatalf("GetPokemonDetails(\"pikachu\") failed: %v", err)
+	}
+	species, err := pokemon.Species.Resolve(ctx, c)
+	if err != nil || species.CaptureRate != 190 {
+		t.Fatalf("Species.Resolve() = %+v, %v, want the species of pikachu.", species, err)
+	}
+}
+
+func TestNamedAPIResourceCached(t *testing.T) {
+	c := newTestClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`{"name": "pikachu", "capture_rate": 190}`))
+	}))
+	r := NamedAPIResource[PokeapiSpecies]{Name: "pikachu", URL: c.endpointURL(speciesEndpoint, "25/")}
+
+	if _, ok := r.Cached(c); ok {
+		t.Fatalf("Cached() found the species before it was fetched.")
+	}
+	if _, err := r.Resolve(context.Background(), c); err != nil {
+		t.Fatalf("Resolve() failed: %v", err)
+	}
+	if species, ok := r.Cached(c); !ok || species.CaptureRate != 190 {
+		t.Fatalf("Cached() = %+v, %v after Resolve(), want the species of pikachu.", species, ok)
+	}
+}
@@ -0,0 +1,50 @@
+package pokeapi
+
+import (
+	"context"
+	"errors"
+	"math/rand/v2"
+	"time"
+)
+
+// Bounds for the delay between retries
+const (
+	backoffBase = 250 * time.Millisecond
+	backoffMax  = 5 * time.Second
+)
+
+// isTransient reports whether a request that failed with err may succeed if retried.
+func isTransient(ctx context.Context, err error) bool {
+	// The caller gave up, as opposed to a single attempt timing out
+	if ctx.Err() != nil {
+		return false
+	}
+	var statusErr *StatusError
+	if errors.As(err, &statusErr) {
+		return errors.Is(err, ErrRateLimited) || errors.Is(err, ErrServerError)
+	}
+	// Anything else is a network error or a timeout of the attempt
+	return true
+}
+
+// backoff returns how long to wait before retry number attempt+1, using
+// exponential backoff with full jitter so that clients don't retry in lockstep.
+func backoff(attempt int) time.Duration {
+	d := backoffMax
+	if attempt < 16 {
+		d = min(backoffBase<<attempt, backoffMax)
+	}
+	return rand.N(d) + 1
+}
+
+// sleep waits for d, or until ctx is done.
+func sleep(ctx context.Context, d time.Duration) error {
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+	select {
+	case <-timer.C:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
@@ -0,0 +1,61 @@
+package pokeapi
+
+import (
+	"bytes"
+	"net/http"
+	"strings"
+)
+
+// A cachedResponse is a response body stored in the Cache together with the
+// validators needed to revalidate it with a conditional request once it expires.
+type cachedResponse struct {
+	etag         string
+	lastModified string
+	body         []byte
+}
+
+// Cached responses are stored as a header identifying the format, one line
+// per validator and an empty line, followed by the body, similar to HTTP.
+const cachedResponseHeader = "pokerepl-response-v1\n"
+
+func (r cachedResponse) encode() []byte {
+	var b bytes.Buffer
+	b.WriteString(cachedResponseHeader)
+	b.WriteString(r.etag + "\n")
+	b.WriteString(r.lastModified + "\n")
+	b.WriteString("\n")
+	b.Write(r.body)
+	return b.Bytes()
+}
+
+// decodeResponse parses a value stored with encode. Values in any other
+// format are treated as bare response bodies without validators.
+func decodeResponse(val []byte) cachedResponse {
+	rest, ok := bytes.CutPrefix(val, []byte(cachedResponseHeader))
+	if !ok {
+		return cachedResponse{body: val}
+	}
+	lines := bytes.SplitN(rest, []byte("\n"), 4)
+	if len(lines) != 4 || len(lines[2]) != 0 {
+		return cachedResponse{body: val}
+	}
+	return cachedResponse{string(lines[0]), string(lines[1]), lines[3]}
+}
+
+// setConditionalHeaders makes req ask the server to only send a body if it differs from r.
+func (r cachedResponse) setConditionalHeaders(req *http.Request) {
+	if r.etag != "" {
+		req.Header.Set("If-None-Match", r.etag)
+	}
+	if r.lastModified != "" {
+		req.Header.Set("If-Modified-Since", r.lastModified)
+	}
+}
+
+func responseValidators(resp *http.Response) (etag string, lastModified string) {
+	// Validators end up on their own line in the cache, so guard against malformed headers
+	clean := func(s string) string {
+		return strings.NewReplacer("\n", "", "\r", "").Replace(s)
+	}
+	return clean(resp.Header.Get("ETag")), clean(resp.Header.Get("Last-Modified"))
+}
@@ -0,0 +1,96 @@
+package pokeapi
+
+import (
+	"context"
+	"sync"
+)
+
+// A group deduplicates concurrent calls for the same key: while a call for a
+// key is in flight, further calls for that key wait for it and share its result.
+type group struct {
+	mux   sync.Mutex
+	calls map[string]*call
+}
+
+type call struct {
+	// Closed once val and err are set
+	done chan struct{}
+	val  []byte
+	err  error
+	// Number of callers sharing the call, including the one that started it
+	waiters int
+	// Cancels the context fn runs with
+	cancel context.CancelFunc
+}
+
+// do runs fn for key, unless a call for key is already in flight, in which case
+// it waits for that call instead. shared reports whether the result came from another call.
+//
+// fn runs with a context that carries the values of ctx, but is only cancelled
+// once every caller waiting for the call has given up, so one caller cancelling
+// its ctx doesn't fail the call for the others. Callers return with ctx.Err() as
+// soon as their own ctx is done.
+func (g *group) do(ctx context.Context, key string, fn func(ctx context.Context) ([]byte, error)) (val []byte, err error, shared bool) {
+	g.mux.Lock()
+	if g.calls == nil {
+		g.calls = make(map[string]*call)
+	}
+	c, shared := g.calls[key]
+	if shared {
+		c.waiters++
+	} else {
+		fnCtx, cancel := context.WithCancel(context.WithoutCancel(ctx))
+		c = &call{done: make(chan struct{}), waiters: 1, cancel: cancel}
+		g.calls[key] = c
+		go g.run(fnCtx, key, c, fn)
+	}
+	g.mux.Unlock()
+
+	select {
+	case <-c.done:
+		return c.val, c.err, shared
+	case <-ctx.Done():
+		g.leave(key, c)
+		return nil, ctx.Err(), shared
+	}
+}
+
+func (g *group) run(ctx context.Context, key string, c *call, fn func(ctx context.Context) ([]byte, error)) {
+	c.val, c.err = fn(ctx)
+	c.cancel()
+
+	g.mux.Lock()
+	g.forget(key, c)
+	g.mux.Unlock()
+	close(c.done)
+}
+
+// leave stops a caller from waiting for c, cancelling c if it was the last one.
+func (g *group) leave(key string, c *call) {
+	g.mux.Lock()
+	defer g.mux.Unlock()
+	c.waiters--
+	if c.waiters == 0 {
+		c.cancel()
+		// Later callers must not join a call that is being cancelled
+		g.forget(key, c)
+	}
+}
+
+// forget removes c from the calls in flight, unless it has been replaced already.
+// Must be called with g.mux held.
+func (g *group) forget(key string, c *call) {
+	if g.calls[key] == c {
+		delete(g.calls, key)
+	}
+}
+
+// waiters returns the number of callers sharing the call for key, or 0 if there is none.
+func (g *group) waiters(key string) int {
+	g.mux.Lock()
+	defer g.mux.Unlock()
+	if c, ok := g.calls[key]; ok {
+		return c.waiters
+	}
+	return 0
+}
@@ -0,0 +1,109 @@
+package pokeapi
+
+import (
+	"context"
+	"errors"
+	"runtime"
+	"sync"
+	"sync/atomic"
+	"testing"
+)
+
+func TestGroupDeduplicates(t *testing.T) {
+	const n = 10
+	var g group
+	var calls atomic.Int32
+	fn := func(context.Context) ([]byte, error) {
+		calls.Add(1)
+		// Complete the call only once every goroutine has joined it
+		for g.waiters("key") < n {
+			runtime.Gosched()
+		}
+		return []byte("body"), nil
+	}
+
+	var done sync.WaitGroup
+	done.Add(n)
+	results := make([][]byte, n)
+	for i := range n {
+		go func() {
+			results[i], _, _ = g.do(context.Background(), "key", fn)
+			done.Done()
+		}()
+	}
+	done.Wait()
+
+	if got := calls.Load(); got != 1 {
+		t.Fatalf("fn was called %v times, want 1.", got)
+	}
+	for i, r := range results {
+		if string(r) != "body" {
+			t.Fatalf(`results[%v] = %q, want "body".`, i, r)
+		}
+	}
+}
+
+func TestGroupCancelOneWaiter(t *testing.T) {
+	var g group
+	release := make(chan struct{})
+	fn := func(ctx context.Context) ([]byte, error) {
+		select {
+		case <-release:
+			return []byte("body"), nil
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		}
+	}
+
+	first, cancel := context.WithCancel(context.Background())
+	firstErr := make(chan error)
+	go func() {
+		_, err, _ := g.do(first, "key", fn)
+		firstErr <- err
+	}()
+	for g.waiters("key") < 1 {
+		runtime.Gosched()
+	}
+	secondVal := make(chan []byte)
+	go func() {
+		val, _, _ := g.do(context.Background(), "key", fn)
+		secondVal <- val
+	}()
+	for g.waiters("key") < 2 {
+		runtime.Gosched()
+	}
+
+	cancel()
+	if err := <-firstErr; !errors.Is(err, context.Canceled) {
+		t.Fatalf("The cancelled caller got %v, want context.Canceled.", err)
+	}
+	close(release)
+	if val := <-secondVal; string(val) != "body" {
+		t.Fatalf(`The other caller got %q, want "body".`, val)
+	}
+}
+
+func TestGroupCancelAllWaiters(t *testing.T) {
+	var g group
+	fnErr := make(chan error, 1)
+	fn := func(ctx context.Context) ([]byte, error) {
+		<-ctx.Done()
+		fnErr <- ctx.Err()
+		return nil, ctx.Err()
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan struct{})
+	go func() {
+		g.do(ctx, "key", fn)
+		close(done)
+	}()
+	for g.waiters("key") < 1 {
+		runtime.Gosched()
+	}
+	cancel()
+	<-done
+	if err := <-fnErr; !errors.Is(err, context.Canceled) {
+		t.Fatalf("fn got %v once every caller left, want context.Canceled.", err)
+	}
+}
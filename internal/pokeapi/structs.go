@@ -1,54 +1,7 @@
 package pokeapi
 
-import (
-	"encoding/json"
-	"github.com/madsbv/pokerepl/internal/pokecache"
-	"io"
-	"net/http"
-)
-
-func getParsedResponse[T any](query string, cache pokecache.Cache) (T, error) {
-	t := *new(T)
-
-	body, err := getPokeapiJSONResponse(query, cache)
-	if err != nil {
-		return t, err
-	}
-
-	err = json.Unmarshal(body, &t)
-	return t, err
-}
-
-func getPokeapiJSONResponse(query string, cache pokecache.Cache) ([]byte, error) {
-	body, exists := cache.Get(query)
-	if exists {
-		return body, nil
-	}
-	resp, err := http.Get(query)
-	if err != nil {
-		return nil, err
-	}
-	defer resp.Body.Close()
-	respBody, err := io.ReadAll(resp.Body)
-	if err != nil {
-		return nil, err
-	}
-	cache.Add(query, respBody)
-	return respBody, nil
-}
-
 type PokeapiResponse interface {
-	LocationList | PokeapiLocation
-}
-
-type LocationList struct {
-	Count    int     `json:"count"`
-	Next     *string `json:"next"`
-	Previous *string `json:"previous"`
-	Results  []struct {
-		Name string `json:"name"`
-		URL  string `json:"url"`
-	} `json:"results"`
+	NamedAPIResourceList[PokeapiLocation] | PokeapiLocation
 }
 
 // Generated with https://mholt.github.io/json-to-go/ from the example at https://pokeapi.co/docs/v2#location-areas
@@ -57,51 +10,17 @@ type PokeapiLocation struct {
 	Name                 string `json:"name"`
 	GameIndex            int    `json:"game_index"`
 	EncounterMethodRates []struct {
-		EncounterMethod struct {
-			Name string `json:"name"`
-			URL  string `json:"url"`
-		} `json:"encounter_method"`
-		VersionDetails []struct {
-			Rate    int `json:"rate"`
-			Version struct {
-				Name string `json:"name"`
-				URL  string `json:"url"`
-			} `json:"version"`
+		EncounterMethod NamedAPIResource[Resource] `json:"encounter_method"`
+		VersionDetails  []struct {
+			Rate    int                        `json:"rate"`
+			Version NamedAPIResource[Resource] `json:"version"`
 		} `json:"version_details"`
 	} `json:"encounter_method_rates"`
-	Location struct {
-		Name string `json:"name"`
-		URL  string `json:"url"`
-	} `json:"location"`
-	Names []struct {
-		Name     string `json:"name"`
-		Language struct {
-			Name string `json:"name"`
-			URL  string `json:"url"`
-		} `json:"language"`
-	} `json:"names"`
+	Location          NamedAPIResource[PokeapiPlace] `json:"location"`
+	Names             []Name                         `json:"names"`
 	PokemonEncounters []struct {
-		Pokemon struct {
-			Name string `json:"name"`
-			URL  string `json:"url"`
-		} `json:"pokemon"`
-		VersionDetails []struct {
-			Version struct {
-				Name string `json:"name"`
-				URL  string `json:"url"`
-			} `json:"version"`
-			MaxChance        int `json:"max_chance"`
-			EncounterDetails []struct {
-				MinLevel        int   `json:"min_level"`
-				MaxLevel        int   `json:"max_level"`
-				ConditionValues []any `json:"condition_values"`
-				Chance          int   `json:"chance"`
-				Method          struct {
-					Name string `json:"name"`
-					URL  string `json:"url"`
-				} `json:"method"`
-			} `json:"encounter_details"`
-		} `json:"version_details"`
+		Pokemon        NamedAPIResource[PokeapiPokemon] `json:"pokemon"`
+		VersionDetails []VersionEncounterDetail         `json:"version_details"`
 	} `json:"pokemon_encounters"`
 }
 
@@ -114,59 +33,32 @@ type PokeapiPokemon struct {
 	Order          int    `json:"order,omitempty"`
 	Weight         int    `json:"weight,omitempty"`
 	Abilities      []struct {
-		IsHidden bool `json:"is_hidden,omitempty"`
-		Slot     int  `json:"slot,omitempty"`
-		Ability  struct {
-			Name string `json:"name,omitempty"`
-			URL  string `json:"url,omitempty"`
-		} `json:"ability,omitempty"`
+		IsHidden bool                             `json:"is_hidden,omitempty"`
+		Slot     int                              `json:"slot,omitempty"`
+		Ability  NamedAPIResource[PokeapiAbility] `json:"ability,omitempty"`
 	} `json:"abilities,omitempty"`
-	Forms []struct {
-		Name string `json:"name,omitempty"`
-		URL  string `json:"url,omitempty"`
-	} `json:"forms,omitempty"`
+	Forms       []NamedAPIResource[Resource] `json:"forms,omitempty"`
 	GameIndices []struct {
-		GameIndex int `json:"game_index,omitempty"`
-		Version   struct {
-			Name string `json:"name,omitempty"`
-			URL  string `json:"url,omitempty"`
-		} `json:"version,omitempty"`
+		GameIndex int                        `json:"game_index,omitempty"`
+		Version   NamedAPIResource[Resource] `json:"version,omitempty"`
 	} `json:"game_indices,omitempty"`
 	HeldItems []struct {
-		Item struct {
-			Name string `json:"name,omitempty"`
-			URL  string `json:"url,omitempty"`
-		} `json:"item,omitempty"`
+		Item           NamedAPIResource[PokeapiItem] `json:"item,omitempty"`
 		VersionDetails []struct {
-			Rarity  int `json:"rarity,omitempty"`
-			Version struct {
-				Name string `json:"name,omitempty"`
-				URL  string `json:"url,omitempty"`
-			} `json:"version,omitempty"`
+			Rarity  int                        `json:"rarity,omitempty"`
+			Version NamedAPIResource[Resource] `json:"version,omitempty"`
 		} `json:"version_details,omitempty"`
 	} `json:"held_items,omitempty"`
 	LocationAreaEncounters string `json:"location_area_encounters,omitempty"`
 	Moves                  []struct {
-		Move struct {
-			Name string `json:"name,omitempty"`
-			URL  string `json:"url,omitempty"`
-		} `json:"move,omitempty"`
+		Move                NamedAPIResource[PokeapiMove] `json:"move,omitempty"`
 		VersionGroupDetails []struct {
-			LevelLearnedAt int `json:"level_learned_at,omitempty"`
-			VersionGroup   struct {
-				Name string `json:"name,omitempty"`
-				URL  string `json:"url,omitempty"`
-			} `json:"version_group,omitempty"`
-			MoveLearnMethod struct {
-				Name string `json:"name,omitempty"`
-				URL  string `json:"url,omitempty"`
-			} `json:"move_learn_method,omitempty"`
+			LevelLearnedAt  int                        `json:"level_learned_at,omitempty"`
+			VersionGroup    NamedAPIResource[Resource] `json:"version_group,omitempty"`
+			MoveLearnMethod NamedAPIResource[Resource] `json:"move_learn_method,omitempty"`
 		} `json:"version_group_details,omitempty"`
 	} `json:"moves,omitempty"`
-	Species struct {
-		Name string `json:"name,omitempty"`
-		URL  string `json:"url,omitempty"`
-	} `json:"species,omitempty"`
+	Species NamedAPIResource[PokeapiSpecies] `json:"species,omitempty"`
 	Sprites struct {
 		BackDefault      string `json:"back_default,omitempty"`
 		BackFemale       any    `json:"back_female,omitempty"`
@@ -348,31 +240,334 @@ type PokeapiPokemon struct {
 		Legacy string `json:"legacy,omitempty"`
 	} `json:"cries,omitempty"`
 	Stats []struct {
-		BaseStat int `json:"base_stat,omitempty"`
-		Effort   int `json:"effort,omitempty"`
-		Stat     struct {
-			Name string `json:"name,omitempty"`
-			URL  string `json:"url,omitempty"`
-		} `json:"stat,omitempty"`
+		BaseStat int                        `json:"base_stat,omitempty"`
+		Effort   int                        `json:"effort,omitempty"`
+		Stat     NamedAPIResource[Resource] `json:"stat,omitempty"`
 	} `json:"stats,omitempty"`
 	Types []struct {
-		Slot int `json:"slot,omitempty"`
-		Type struct {
-			Name string `json:"name,omitempty"`
-			URL  string `json:"url,omitempty"`
-		} `json:"type,omitempty"`
+		Slot int                           `json:"slot,omitempty"`
+		Type NamedAPIResource[PokeapiType] `json:"type,omitempty"`
 	} `json:"types,omitempty"`
 	PastTypes []struct {
-		Generation struct {
-			Name string `json:"name,omitempty"`
-			URL  string `json:"url,omitempty"`
-		} `json:"generation,omitempty"`
-		Types []struct {
-			Slot int `json:"slot,omitempty"`
-			Type struct {
-				Name string `json:"name,omitempty"`
-				URL  string `json:"url,omitempty"`
-			} `json:"type,omitempty"`
+		Generation NamedAPIResource[PokeapiGeneration] `json:"generation,omitempty"`
+		Types      []struct {
+			Slot int                           `json:"slot,omitempty"`
+			Type NamedAPIResource[PokeapiType] `json:"type,omitempty"`
 		} `json:"types,omitempty"`
 	} `json:"past_types,omitempty"`
 }
+
+// Generated with https://mholt.github.io/json-to-go/ from the example at https://pokeapi.co/docs/v2#pokemon-species
+type PokeapiSpecies struct {
+	ID                   int                        `json:"id"`
+	Name                 string                     `json:"name"`
+	Order                int                        `json:"order"`
+	GenderRate           int                        `json:"gender_rate"`
+	CaptureRate          int                        `json:"capture_rate"`
+	BaseHappiness        int                        `json:"base_happiness"`
+	IsBaby               bool                       `json:"is_baby"`
+	IsLegendary          bool                       `json:"is_legendary"`
+	IsMythical           bool                       `json:"is_mythical"`
+	HatchCounter         int                        `json:"hatch_counter"`
+	HasGenderDifferences bool                       `json:"has_gender_differences"`
+	FormsSwitchable      bool                       `json:"forms_switchable"`
+	GrowthRate           NamedAPIResource[Resource] `json:"growth_rate"`
+	PokedexNumbers       []struct {
+		EntryNumber int                        `json:"entry_number"`
+		Pokedex     NamedAPIResource[Resource] `json:"pokedex"`
+	} `json:"pokedex_numbers"`
+	EggGroups          []NamedAPIResource[Resource]        `json:"egg_groups"`
+	Color              NamedAPIResource[Resource]          `json:"color"`
+	Shape              NamedAPIResource[Resource]          `json:"shape"`
+	EvolvesFromSpecies NamedAPIResource[PokeapiSpecies]    `json:"evolves_from_species"`
+	EvolutionChain     APIResource[PokeapiEvolutionChain]  `json:"evolution_chain"`
+	Habitat            NamedAPIResource[Resource]          `json:"habitat"`
+	Generation         NamedAPIResource[PokeapiGeneration] `json:"generation"`
+	Names              []Name                              `json:"names"`
+	FlavorTextEntries  []struct {
+		FlavorText string                     `json:"flavor_text"`
+		Language   NamedAPIResource[Resource] `json:"language"`
+		Version    NamedAPIResource[Resource] `json:"version"`
+	} `json:"flavor_text_entries"`
+	FormDescriptions []struct {
+		Description string                     `json:"description"`
+		Language    NamedAPIResource[Resource] `json:"language"`
+	} `json:"form_descriptions"`
+	Genera []struct {
+		Genus    string                     `json:"genus"`
+		Language NamedAPIResource[Resource] `json:"language"`
+	} `json:"genera"`
+	Varieties []struct {
+		IsDefault bool                             `json:"is_default"`
+		Pokemon   NamedAPIResource[PokeapiPokemon] `json:"pokemon"`
+	} `json:"varieties"`
+}
+
+// Generated with https://mholt.github.io/json-to-go/ from the example at https://pokeapi.co/docs/v2#evolution-chains
+// and split up, since chain links are recursive.
+type PokeapiEvolutionChain struct {
+	ID              int                           `json:"id"`
+	BabyTriggerItem NamedAPIResource[PokeapiItem] `json:"baby_trigger_item"`
+	Chain           ChainLink                     `json:"chain"`
+}
+
+type ChainLink struct {
+	IsBaby           bool                             `json:"is_baby"`
+	Species          NamedAPIResource[PokeapiSpecies] `json:"species"`
+	EvolutionDetails []EvolutionDetail                `json:"evolution_details"`
+	EvolvesTo        []ChainLink                      `json:"evolves_to"`
+}
+
+type EvolutionDetail struct {
+	Item    NamedAPIResource[PokeapiItem] `json:"item"`
+	Trigger NamedAPIResource[Resource]    `json:"trigger"`
+	// 1 for female, 2 for male, 0 if either works
+	Gender             int                              `json:"gender"`
+	HeldItem           NamedAPIResource[PokeapiItem]    `json:"held_item"`
+	KnownMove          NamedAPIResource[PokeapiMove]    `json:"known_move"`
+	KnownMoveType      NamedAPIResource[PokeapiType]    `json:"known_move_type"`
+	Location           NamedAPIResource[PokeapiPlace]   `json:"location"`
+	MinLevel           int                              `json:"min_level"`
+	MinHappiness       int                              `json:"min_happiness"`
+	MinBeauty          int                              `json:"min_beauty"`
+	MinAffection       int                              `json:"min_affection"`
+	NeedsOverworldRain bool                             `json:"needs_overworld_rain"`
+	PartySpecies       NamedAPIResource[PokeapiSpecies] `json:"party_species"`
+	PartyType          NamedAPIResource[PokeapiType]    `json:"party_type"`
+	// Sign of Attack minus Defense required to evolve, or nil if it does not matter
+	RelativePhysicalStats *int                             `json:"relative_physical_stats"`
+	TimeOfDay             string                           `json:"time_of_day"`
+	TradeSpecies          NamedAPIResource[PokeapiSpecies] `json:"trade_species"`
+	TurnUpsideDown        bool                             `json:"turn_upside_down"`
+}
+
+// Generated with https://mholt.github.io/json-to-go/ from the example at https://pokeapi.co/docs/v2#types
+type PokeapiType struct {
+	ID              int    `json:"id"`
+	Name            string `json:"name"`
+	DamageRelations struct {
+		NoDamageTo       []NamedAPIResource[PokeapiType] `json:"no_damage_to"`
+		HalfDamageTo     []NamedAPIResource[PokeapiType] `json:"half_damage_to"`
+		DoubleDamageTo   []NamedAPIResource[PokeapiType] `json:"double_damage_to"`
+		NoDamageFrom     []NamedAPIResource[PokeapiType] `json:"no_damage_from"`
+		HalfDamageFrom   []NamedAPIResource[PokeapiType] `json:"half_damage_from"`
+		DoubleDamageFrom []NamedAPIResource[PokeapiType] `json:"double_damage_from"`
+	} `json:"damage_relations"`
+	GameIndices []struct {
+		GameIndex  int                                 `json:"game_index"`
+		Generation NamedAPIResource[PokeapiGeneration] `json:"generation"`
+	} `json:"game_indices"`
+	Generation      NamedAPIResource[PokeapiGeneration] `json:"generation"`
+	MoveDamageClass NamedAPIResource[Resource]          `json:"move_damage_class"`
+	Names           []Name                              `json:"names"`
+	Pokemon         []struct {
+		Slot    int                              `json:"slot"`
+		Pokemon NamedAPIResource[PokeapiPokemon] `json:"pokemon"`
+	} `json:"pokemon"`
+	Moves []NamedAPIResource[PokeapiMove] `json:"moves"`
+}
+
+// Generated with https://mholt.github.io/json-to-go/ from the example at https://pokeapi.co/docs/v2#moves
+type PokeapiMove struct {
+	ID   int    `json:"id"`
+	Name string `json:"name"`
+	// Accuracy, EffectChance and Power are nil for moves that always hit, have no secondary effect or deal no direct damage
+	Accuracy      *int                       `json:"accuracy"`
+	EffectChance  *int                       `json:"effect_chance"`
+	Pp            int                        `json:"pp"`
+	Priority      int                        `json:"priority"`
+	Power         *int                       `json:"power"`
+	DamageClass   NamedAPIResource[Resource] `json:"damage_class"`
+	EffectEntries []struct {
+		Effect      string                     `json:"effect"`
+		ShortEffect string                     `json:"short_effect"`
+		Language    NamedAPIResource[Resource] `json:"language"`
+	} `json:"effect_entries"`
+	FlavorTextEntries []struct {
+		FlavorText   string                     `json:"flavor_text"`
+		Language     NamedAPIResource[Resource] `json:"language"`
+		VersionGroup NamedAPIResource[Resource] `json:"version_group"`
+	} `json:"flavor_text_entries"`
+	Generation       NamedAPIResource[PokeapiGeneration] `json:"generation"`
+	LearnedByPokemon []NamedAPIResource[PokeapiPokemon]  `json:"learned_by_pokemon"`
+	Meta             struct {
+		Ailment       NamedAPIResource[Resource] `json:"ailment"`
+		Category      NamedAPIResource[Resource] `json:"category"`
+		MinHits       int                        `json:"min_hits"`
+		MaxHits       int                        `json:"max_hits"`
+		MinTurns      int                        `json:"min_turns"`
+		MaxTurns      int                        `json:"max_turns"`
+		Drain         int                        `json:"drain"`
+		Healing       int                        `json:"healing"`
+		CritRate      int                        `json:"crit_rate"`
+		AilmentChance int                        `json:"ailment_chance"`
+		FlinchChance  int                        `json:"flinch_chance"`
+		StatChance    int                        `json:"stat_chance"`
+	} `json:"meta"`
+	Names       []Name `json:"names"`
+	StatChanges []struct {
+		Change int                        `json:"change"`
+		Stat   NamedAPIResource[Resource] `json:"stat"`
+	} `json:"stat_changes"`
+	Target NamedAPIResource[Resource]    `json:"target"`
+	Type   NamedAPIResource[PokeapiType] `json:"type"`
+}
+
+// Generated with https://mholt.github.io/json-to-go/ from the example at https://pokeapi.co/docs/v2#abilities
+type PokeapiAbility struct {
+	ID            int                                 `json:"id"`
+	Name          string                              `json:"name"`
+	IsMainSeries  bool                                `json:"is_main_series"`
+	Generation    NamedAPIResource[PokeapiGeneration] `json:"generation"`
+	Names         []Name                              `json:"names"`
+	EffectEntries []struct {
+		Effect      string                     `json:"effect"`
+		ShortEffect string                     `json:"short_effect"`
+		Language    NamedAPIResource[Resource] `json:"language"`
+	} `json:"effect_entries"`
+	FlavorTextEntries []struct {
+		FlavorText   string                     `json:"flavor_text"`
+		Language     NamedAPIResource[Resource] `json:"language"`
+		VersionGroup NamedAPIResource[Resource] `json:"version_group"`
+	} `json:"flavor_text_entries"`
+	Pokemon []struct {
+		IsHidden bool                             `json:"is_hidden"`
+		Slot     int                              `json:"slot"`
+		Pokemon  NamedAPIResource[PokeapiPokemon] `json:"pokemon"`
+	} `json:"pokemon"`
+}
+
+// Generated with https://mholt.github.io/json-to-go/ from the example at https://pokeapi.co/docs/v2#item
+type PokeapiItem struct {
+	ID   int    `json:"id"`
+	Name string `json:"name"`
+	Cost int    `json:"cost"`
+	// Nil for items that can't be flung
+	FlingPower    *int                                  `json:"fling_power"`
+	FlingEffect   NamedAPIResource[Resource]            `json:"fling_effect"`
+	Attributes    []NamedAPIResource[Resource]          `json:"attributes"`
+	Category      NamedAPIResource[PokeapiItemCategory] `json:"category"`
+	EffectEntries []struct {
+		Effect      string                     `json:"effect"`
+		ShortEffect string                     `json:"short_effect"`
+		Language    NamedAPIResource[Resource] `json:"language"`
+	} `json:"effect_entries"`
+	FlavorTextEntries []struct {
+		Text         string                     `json:"text"`
+		VersionGroup NamedAPIResource[Resource] `json:"version_group"`
+		Language     NamedAPIResource[Resource] `json:"language"`
+	} `json:"flavor_text_entries"`
+	Names   []Name `json:"names"`
+	Sprites struct {
+		Default string `json:"default"`
+	} `json:"sprites"`
+	HeldByPokemon []struct {
+		Pokemon        NamedAPIResource[PokeapiPokemon] `json:"pokemon"`
+		VersionDetails []struct {
+			Rarity  int                        `json:"rarity"`
+			Version NamedAPIResource[Resource] `json:"version"`
+		} `json:"version_details"`
+	} `json:"held_by_pokemon"`
+	BabyTriggerFor APIResource[PokeapiEvolutionChain] `json:"baby_trigger_for"`
+}
+
+// Generated with https://mholt.github.io/json-to-go/ from the example at https://pokeapi.co/docs/v2#item-categories
+type PokeapiItemCategory struct {
+	ID     int                             `json:"id"`
+	Name   string                          `json:"name"`
+	Items  []NamedAPIResource[PokeapiItem] `json:"items"`
+	Names  []Name                          `json:"names"`
+	Pocket NamedAPIResource[Resource]      `json:"pocket"`
+}
+
+// Generated with https://mholt.github.io/json-to-go/ from the example at https://pokeapi.co/docs/v2#berries
+type PokeapiBerry struct {
+	ID               int                        `json:"id"`
+	Name             string                     `json:"name"`
+	GrowthTime       int                        `json:"growth_time"`
+	MaxHarvest       int                        `json:"max_harvest"`
+	NaturalGiftPower int                        `json:"natural_gift_power"`
+	Size             int                        `json:"size"`
+	Smoothness       int                        `json:"smoothness"`
+	SoilDryness      int                        `json:"soil_dryness"`
+	Firmness         NamedAPIResource[Resource] `json:"firmness"`
+	Flavors          []struct {
+		Potency int                                  `json:"potency"`
+		Flavor  NamedAPIResource[PokeapiBerryFlavor] `json:"flavor"`
+	} `json:"flavors"`
+	Item            NamedAPIResource[PokeapiItem] `json:"item"`
+	NaturalGiftType NamedAPIResource[PokeapiType] `json:"natural_gift_type"`
+}
+
+// Generated with https://mholt.github.io/json-to-go/ from the example at https://pokeapi.co/docs/v2#berry-flavors
+type PokeapiBerryFlavor struct {
+	ID      int    `json:"id"`
+	Name    string `json:"name"`
+	Berries []struct {
+		Potency int                            `json:"potency"`
+		Berry   NamedAPIResource[PokeapiBerry] `json:"berry"`
+	} `json:"berries"`
+	ContestType NamedAPIResource[Resource] `json:"contest_type"`
+	Names       []Name                     `json:"names"`
+}
+
+// Generated with https://mholt.github.io/json-to-go/ from the example at https://pokeapi.co/docs/v2#pokemon-location-areas
+type LocationAreaEncounter struct {
+	LocationArea   NamedAPIResource[PokeapiLocation] `json:"location_area"`
+	VersionDetails []VersionEncounterDetail          `json:"version_details"`
+}
+
+type VersionEncounterDetail struct {
+	Version NamedAPIResource[Resource] `json:"version"`
+	// Total chance of all the encounters in EncounterDetails
+	MaxChance        int         `json:"max_chance"`
+	EncounterDetails []Encounter `json:"encounter_details"`
+}
+
+type Encounter struct {
+	MinLevel        int                          `json:"min_level"`
+	MaxLevel        int                          `json:"max_level"`
+	ConditionValues []NamedAPIResource[Resource] `json:"condition_values"`
+	Chance          int                          `json:"chance"`
+	Method          NamedAPIResource[Resource]   `json:"method"`
+}
+
+// Generated with https://mholt.github.io/json-to-go/ from the example at https://pokeapi.co/docs/v2#regions
+type PokeapiRegion struct {
+	ID             int                                 `json:"id"`
+	Name           string                              `json:"name"`
+	Locations      []NamedAPIResource[PokeapiPlace]    `json:"locations"`
+	MainGeneration NamedAPIResource[PokeapiGeneration] `json:"main_generation"`
+	Names          []Name                              `json:"names"`
+	Pokedexes      []NamedAPIResource[Resource]        `json:"pokedexes"`
+	VersionGroups  []NamedAPIResource[Resource]        `json:"version_groups"`
+}
+
+// A PokeapiPlace is what PokeAPI calls a location, e.g. a town or a route, which is split into location areas.
+// The name PokeapiLocation was already taken by location areas.
+//
+// Generated with https://mholt.github.io/json-to-go/ from the example at https://pokeapi.co/docs/v2#locations
+type PokeapiPlace struct {
+	ID          int                             `json:"id"`
+	Name        string                          `json:"name"`
+	Region      NamedAPIResource[PokeapiRegion] `json:"region"`
+	Names       []Name                          `json:"names"`
+	GameIndices []struct {
+		GameIndex  int                                 `json:"game_index"`
+		Generation NamedAPIResource[PokeapiGeneration] `json:"generation"`
+	} `json:"game_indices"`
+	Areas []NamedAPIResource[PokeapiLocation] `json:"areas"`
+}
+
+// Generated with https://mholt.github.io/json-to-go/ from the example at https://pokeapi.co/docs/v2#generations
+type PokeapiGeneration struct {
+	ID             int                                `json:"id"`
+	Name           string                             `json:"name"`
+	Abilities      []NamedAPIResource[PokeapiAbility] `json:"abilities"`
+	MainRegion     NamedAPIResource[PokeapiRegion]    `json:"main_region"`
+	Moves          []NamedAPIResource[PokeapiMove]    `json:"moves"`
+	Names          []Name                             `json:"names"`
+	PokemonSpecies []NamedAPIResource[PokeapiSpecies] `json:"pokemon_species"`
+	Types          []NamedAPIResource[PokeapiType]    `json:"types"`
+	VersionGroups  []NamedAPIResource[Resource]       `json:"version_groups"`
+}
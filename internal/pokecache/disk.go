@@ -0,0 +1,106 @@
+package pokecache
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+)
+
+// On-disk representation of a cacheEntry. Each entry is stored in its own file,
+// named after a hash of the key since keys are URLs.
+type diskEntry struct {
+	Key       string    `json:"key"`
+	CreatedAt time.Time `json:"created_at"`
+	ExpiresAt time.Time `json:"expires_at"`
+	Val       []byte    `json:"val"`
+}
+
+const diskEntryExt = ".json"
+
+func (c *Cache) entryPath(key string) string {
+	sum := sha256.Sum256([]byte(key))
+	return filepath.Join(c.dir, hex.EncodeToString(sum[:])+diskEntryExt)
+}
+
+// load reads all entries persisted in c.dir, discarding those that are dead at now.
+// Expects to be called before the cache is shared, so does not lock.
+func (c *Cache) load(now time.Time) {
+	if err := os.MkdirAll(c.dir, 0o755); err != nil {
+		c.dir = ""
+		return
+	}
+	files, err := os.ReadDir(c.dir)
+	if err != nil {
+		return
+	}
+	var loaded []*cacheEntry
+	for _, f := range files {
+		if f.IsDir() || !strings.HasSuffix(f.Name(), diskEntryExt) {
+			continue
+		}
+		path := filepath.Join(c.dir, f.Name())
+		data, err := os.ReadFile(path)
+		if err != nil {
+			continue
+		}
+		var e diskEntry
+		if err := json.Unmarshal(data, &e); err != nil {
+			os.Remove(path)
+			continue
+		}
+		entry := &cacheEntry{e.Key, e.CreatedAt, e.ExpiresAt, e.Val}
+		if c.isDead(entry, now) {
+			os.Remove(path)
+			continue
+		}
+		loaded = append(loaded, entry)
+	}
+
+	// Recency is not persisted, so treat the newest entries as the most recently used ones
+	sort.Slice(loaded, func(i, j int) bool {
+		return loaded[i].createdAt.Before(loaded[j].createdAt)
+	})
+	for _, entry := range loaded {
+		c.insert(entry)
+	}
+}
+
+// store persists entry. Must be called with c.mux held.
+func (c *Cache) store(entry *cacheEntry) {
+	if c.dir == "" {
+		return
+	}
+	data, err := json.Marshal(diskEntry{entry.key, entry.createdAt, entry.expiresAt, entry.val})
+	if err != nil {
+		return
+	}
+	// Write to a temporary file first so a crash never leaves a truncated entry behind
+	tmp, err := os.CreateTemp(c.dir, "tmp-*")
+	if err != nil {
+		return
+	}
+	_, err = tmp.Write(data)
+	if closeErr := tmp.Close(); err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		os.Remove(tmp.Name())
+		return
+	}
+	if err := os.Rename(tmp.Name(), c.entryPath(entry.key)); err != nil {
+		os.Remove(tmp.Name())
+	}
+}
+
+// remove deletes the persisted copy of key, if any. Must be called with c.mux held.
+func (c *Cache) remove(key string) {
+	if c.dir == "" {
+		return
+	}
+	os.Remove(c.entryPath(key))
+}
@@ -0,0 +1,16 @@
+package pokecache
+
+import "time"
+
+// Nop is a cache that never stores anything, which is useful for debugging.
+type Nop struct{}
+
+func (Nop) Lookup(key string) ([]byte, bool, bool) {
+	return nil, false, false
+}
+
+func (Nop) Peek(key string) ([]byte, bool, bool) {
+	return nil, false, false
+}
+
+func (Nop) AddWithTTL(key string, val []byte, ttl time.Duration) {}
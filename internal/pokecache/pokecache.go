@@ -1,64 +1,341 @@
 package pokecache
 
 import (
+	"container/list"
 	"sync"
 	"time"
 )
 
-func New(interval time.Duration) Cache {
-	entries := make(map[string]cacheEntry)
-	mux := &sync.Mutex{}
-	cache := Cache{entries, mux}
+// New creates a cache which checks for expired entries every interval.
+// Entries added with Add expire once they are older than interval.
+// The cache runs a background goroutine until it is closed with Close.
+func New(interval time.Duration, opts ...Option) *Cache {
+	cache := &Cache{
+		entries: make(map[string]*list.Element),
+		lru:     list.New(),
+		ttl:     interval,
+		clock:   realClock{},
+		done:    make(chan struct{}),
+	}
+	for _, opt := range opts {
+		opt(cache)
+	}
+
+	if cache.dir != "" {
+		cache.load(cache.clock.Now())
+	}
 
-	go cache.reapLoop(interval)
+	cache.reaper.Add(1)
+	go cache.reapLoop(cache.clock.NewTicker(interval))
 
 	return cache
 }
 
+// Close stops the background reaping of expired entries. The cache remains
+// usable afterwards, but expired entries are only dropped when they are looked up.
+// Close may be called multiple times.
+func (c *Cache) Close() {
+	c.closeOnce.Do(func() {
+		close(c.done)
+	})
+	c.reaper.Wait()
+}
+
+// An Option configures a Cache created by New.
+type Option func(*Cache)
+
+// WithDir makes the cache persist its entries in dir, and load any entries
+// stored there by an earlier Cache. Persistence is best effort: if dir cannot
+// be used, the cache silently falls back to being memory-only.
+func WithDir(dir string) Option {
+	return func(c *Cache) {
+		c.dir = dir
+	}
+}
+
+// WithMaxBytes limits the total size of the values held by the cache to n bytes,
+// evicting the least recently used entries to make room. Values larger than n are not cached.
+func WithMaxBytes(n int) Option {
+	return func(c *Cache) {
+		c.maxBytes = n
+	}
+}
+
+// WithMaxEntries limits the cache to n entries, evicting the least recently used entries to make room.
+func WithMaxEntries(n int) Option {
+	return func(c *Cache) {
+		c.maxEntries = n
+	}
+}
+
+// WithStaleWhileRevalidate keeps expired entries around for up to d after they expire.
+// Get never returns such stale entries, but Lookup does, so that callers can
+// serve a stale value immediately while refreshing it in the background.
+func WithStaleWhileRevalidate(d time.Duration) Option {
+	return func(c *Cache) {
+		c.staleFor = d
+	}
+}
+
+// WithClock makes the cache use clock instead of the system clock, so that tests can control time.
+func WithClock(clock Clock) Option {
+	return func(c *Cache) {
+		c.clock = clock
+	}
+}
+
 type Cache struct {
-	entries map[string]cacheEntry
-	// Could use an RWMutex, but reads basically only happen on user input
-	mux *sync.Mutex
+	entries map[string]*list.Element
+	// Elements hold *cacheEntry, most recently used at the front
+	lru *list.List
+	// Total size of all values in the cache
+	size int
+	// Limits on size and len(entries); 0 means unlimited
+	maxBytes   int
+	maxEntries int
+	// Could use an RWMutex, but Get also updates recency
+	mux sync.Mutex
+	// Directory to persist entries in, or "" for a memory-only cache
+	dir string
+	// Lifetime of entries added with Add
+	ttl time.Duration
+	// How long expired entries are kept around for Lookup
+	staleFor time.Duration
+	clock    Clock
+	// Closed by Close to stop reapLoop
+	done      chan struct{}
+	closeOnce sync.Once
+	reaper    sync.WaitGroup
+	// Counters reported by Stats
+	hits      int
+	misses    int
+	evictions int
+}
+
+// Stats summarizes the usage of a Cache.
+type Stats struct {
+	Hits   int
+	Misses int
+	// Entries dropped by the cache itself, to respect its limits or because they expired
+	Evictions int
+	Entries   int
+	// Total size of the cached values
+	Bytes int
+}
+
+// EntryInfo describes a single cache entry.
+type EntryInfo struct {
+	Key       string
+	Size      int
+	CreatedAt time.Time
+	ExpiresAt time.Time
 }
 
 type cacheEntry struct {
+	key       string
 	createdAt time.Time
+	expiresAt time.Time
 	val       []byte
 }
 
+// Entries are dropped entirely once they have been expired for longer than staleFor,
+// although Peek keeps returning them until they are reaped
+func (c *Cache) isDead(entry *cacheEntry, now time.Time) bool {
+	return entry.expiresAt.Add(c.staleFor).Before(now)
+}
+
+// Add adds val to the cache under key, using the interval the cache was created with as its lifetime.
 func (c *Cache) Add(key string, val []byte) {
-	createdAt := time.Now()
-	entry := cacheEntry{
+	c.AddWithTTL(key, val, c.ttl)
+}
+
+// AddWithTTL adds val to the cache under key. The entry expires after ttl.
+func (c *Cache) AddWithTTL(key string, val []byte, ttl time.Duration) {
+	createdAt := c.clock.Now()
+	entry := &cacheEntry{
+		key,
 		createdAt,
+		createdAt.Add(ttl),
 		val,
 	}
 	c.mux.Lock()
-	c.entries[key] = entry
-	c.mux.Unlock()
+	defer c.mux.Unlock()
+
+	c.removeElement(key)
+	if c.maxBytes > 0 && len(val) > c.maxBytes {
+		c.remove(key)
+		return
+	}
+	c.insert(entry)
+	c.store(entry)
+}
+
+// Get returns the value stored under key, if it has not expired yet.
+func (c *Cache) Get(key string) ([]byte, bool) {
+	c.mux.Lock()
+	defer c.mux.Unlock()
+
+	val, stale, ok := c.lookup(key)
+	if stale {
+		ok = false
+		val = nil
+	}
+	c.count(ok)
+	return val, ok
+}
+
+// Lookup is like Get, but also returns entries that have expired within the
+// stale window configured with WithStaleWhileRevalidate. For those, stale is true
+// and the caller is expected to refresh the entry.
+func (c *Cache) Lookup(key string) (val []byte, stale bool, ok bool) {
+	c.mux.Lock()
+	defer c.mux.Unlock()
+
+	val, stale, ok = c.lookup(key)
+	c.count(ok)
+	return val, stale, ok
+}
+
+// Peek is like Lookup, but neither counts towards Stats nor marks the entry as recently used.
+// It also returns entries past the stale window, as stale, until they are reaped.
+func (c *Cache) Peek(key string) (val []byte, stale bool, ok bool) {
+	c.mux.Lock()
+	defer c.mux.Unlock()
+
+	el, ok := c.entries[key]
+	if !ok {
+		return nil, false, false
+	}
+	entry := el.Value.(*cacheEntry)
+	return entry.val, entry.expiresAt.Before(c.clock.Now()), true
+}
+
+// Must be called with c.mux held.
+func (c *Cache) lookup(key string) (val []byte, stale bool, ok bool) {
+	el, ok := c.entries[key]
+	if !ok {
+		return nil, false, false
+	}
+	entry := el.Value.(*cacheEntry)
+	now := c.clock.Now()
+	if c.isDead(entry, now) {
+		return nil, false, false
+	}
+	c.lru.MoveToFront(el)
+	return entry.val, entry.expiresAt.Before(now), true
+}
+
+// Must be called with c.mux held.
+func (c *Cache) count(hit bool) {
+	if hit {
+		c.hits++
+	} else {
+		c.misses++
+	}
+}
+
+// Stats returns the current usage statistics of the cache.
+func (c *Cache) Stats() Stats {
+	c.mux.Lock()
+	defer c.mux.Unlock()
+
+	return Stats{
+		Hits:      c.hits,
+		Misses:    c.misses,
+		Evictions: c.evictions,
+		Entries:   len(c.entries),
+		Bytes:     c.size,
+	}
 }
 
-func (c Cache) Get(key string) ([]byte, bool) {
+// List describes all entries in the cache, most recently used first.
+func (c *Cache) List() []EntryInfo {
 	c.mux.Lock()
-	entry, ok := c.entries[key]
-	c.mux.Unlock()
+	defer c.mux.Unlock()
 
-	return entry.val, ok
+	infos := make([]EntryInfo, 0, len(c.entries))
+	for el := c.lru.Front(); el != nil; el = el.Next() {
+		entry := el.Value.(*cacheEntry)
+		infos = append(infos, EntryInfo{entry.key, len(entry.val), entry.createdAt, entry.expiresAt})
+	}
+	return infos
 }
 
-func (c *Cache) reapLoop(interval time.Duration) {
-	ticker := time.NewTicker(interval)
-	for time := range ticker.C {
-		c.cleanBefore(time.Add(-interval))
+// Evict removes key from the cache, and reports whether it was present.
+func (c *Cache) Evict(key string) bool {
+	c.mux.Lock()
+	defer c.mux.Unlock()
+
+	_, ok := c.entries[key]
+	c.removeElement(key)
+	c.remove(key)
+	return ok
+}
+
+// Clear removes all entries from the cache.
+func (c *Cache) Clear() {
+	c.mux.Lock()
+	defer c.mux.Unlock()
+
+	for key := range c.entries {
+		c.removeElement(key)
+		c.remove(key)
+	}
+}
+
+// insert adds entry as the most recently used one and evicts entries until the
+// cache is within its limits again. Must be called with c.mux held.
+func (c *Cache) insert(entry *cacheEntry) {
+	c.entries[entry.key] = c.lru.PushFront(entry)
+	c.size += len(entry.val)
+
+	for c.overLimit() {
+		oldest := c.lru.Back().Value.(*cacheEntry)
+		c.removeElement(oldest.key)
+		c.remove(oldest.key)
+		c.evictions++
+	}
+}
+
+func (c *Cache) overLimit() bool {
+	return (c.maxBytes > 0 && c.size > c.maxBytes) ||
+		(c.maxEntries > 0 && len(c.entries) > c.maxEntries)
+}
+
+// removeElement drops key from memory, if present. Must be called with c.mux held.
+func (c *Cache) removeElement(key string) {
+	el, ok := c.entries[key]
+	if !ok {
+		return
+	}
+	c.lru.Remove(el)
+	delete(c.entries, key)
+	c.size -= len(el.Value.(*cacheEntry).val)
+}
+
+func (c *Cache) reapLoop(ticker Ticker) {
+	defer c.reaper.Done()
+	defer ticker.Stop()
+	for {
+		select {
+		case now := <-ticker.C():
+			c.reap(now)
+		case <-c.done:
+			return
+		}
 	}
 }
 
-func (c *Cache) cleanBefore(time time.Time) {
+// reap drops all entries that are past both their expiry and the stale window.
+func (c *Cache) reap(now time.Time) {
 	c.mux.Lock()
 	defer c.mux.Unlock()
 
-	for key, entry := range c.entries {
-		if entry.createdAt.Before(time) {
-			delete(c.entries, key)
+	for key, el := range c.entries {
+		if c.isDead(el.Value.(*cacheEntry), now) {
+			c.removeElement(key)
+			c.remove(key)
+			c.evictions++
 		}
 	}
 }
@@ -1,12 +1,70 @@
 package pokecache
 
 import (
+	"sync"
 	"testing"
 	"time"
+
+	"github.com/madsbv/pokerepl/internal/pokeapi"
+	"github.com/madsbv/pokerepl/internal/pokeapi/cachetest"
 )
 
+// fakeClock only moves forward when Advance is called.
+type fakeClock struct {
+	mux     sync.Mutex
+	now     time.Time
+	tickers []*fakeTicker
+}
+
+type fakeTicker struct {
+	c      chan time.Time
+	period time.Duration
+	next   time.Time
+}
+
+func newFakeClock() *fakeClock {
+	return &fakeClock{now: time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)}
+}
+
+func (f *fakeClock) Now() time.Time {
+	f.mux.Lock()
+	defer f.mux.Unlock()
+	return f.now
+}
+
+func (f *fakeClock) NewTicker(d time.Duration) Ticker {
+	f.mux.Lock()
+	defer f.mux.Unlock()
+	// Unbuffered, so that Advance returns only once every tick has been received
+	t := &fakeTicker{make(chan time.Time), d, f.now.Add(d)}
+	f.tickers = append(f.tickers, t)
+	return t
+}
+
+// Advance moves the clock forward by d, delivering any ticks that fall due.
+func (f *fakeClock) Advance(d time.Duration) {
+	f.mux.Lock()
+	f.now = f.now.Add(d)
+	now, tickers := f.now, f.tickers
+	f.mux.Unlock()
+
+	for _, t := range tickers {
+		for !t.next.After(now) {
+			t.c <- t.next
+			t.next = t.next.Add(t.period)
+		}
+	}
+}
+
+func (t *fakeTicker) C() <-chan time.Time {
+	return t.c
+}
+
+func (t *fakeTicker) Stop() {}
+
 func TestCacheGet(t *testing.T) {
 	c := New(1 * time.Second)
+	defer c.Close()
 	want := []byte{1, 2}
 	c.Add("key", want)
 	val, exists := c.Get("key")
@@ -16,12 +74,210 @@ func TestCacheGet(t *testing.T) {
 }
 
 func TestCacheReap(t *testing.T) {
-	c := New(10 * time.Millisecond)
+	clock := newFakeClock()
+	c := New(10*time.Millisecond, WithClock(clock))
 	temp := []byte{1}
 	c.Add("key", temp)
-	time.Sleep(20 * time.Millisecond)
+	clock.Advance(20 * time.Millisecond)
+	// Waits for the reaper to finish handling the ticks delivered above
+	c.Close()
+
+	if n := c.Stats().Entries; n != 0 {
+		t.Fatalf(`Cache has %v entries, but "key" should have been evicted from the cache by now.`, n)
+	}
 	val, exists := c.Get("key")
 	if val != nil || exists {
 		t.Fatalf(`Get("key") = %v, %v, but this entry should have been evicted from the cache by now.`, val, exists)
 	}
 }
+
+func TestCacheClose(t *testing.T) {
+	c := New(1 * time.Second)
+	c.Close()
+	// Closing twice must not panic or block
+	c.Close()
+
+	c.Add("key", []byte{1})
+	if _, exists := c.Get("key"); !exists {
+		t.Fatalf(`Get("key") found no entry, but a closed cache should remain usable.`)
+	}
+}
+
+func TestCachePersist(t *testing.T) {
+	dir := t.TempDir()
+	c := New(1*time.Minute, WithDir(dir))
+	defer c.Close()
+	want := []byte{1, 2}
+	c.Add("key", want)
+
+	reloaded := New(1*time.Minute, WithDir(dir))
+	defer reloaded.Close()
+	val, exists := reloaded.Get("key")
+	if !exists || string(val) != string(want) {
+		t.Fatalf(`Get("key") = %v, %v after reload, want %v, true.`, val, exists, want)
+	}
+}
+
+func TestCachePersistReap(t *testing.T) {
+	dir := t.TempDir()
+	clock := newFakeClock()
+	c := New(10*time.Millisecond, WithDir(dir), WithClock(clock))
+	c.Add("key", []byte{1})
+	clock.Advance(20 * time.Millisecond)
+	c.Close()
+
+	// Without the fake clock, the reloaded cache would not consider the entry expired on load
+	reloaded := New(1*time.Minute, WithDir(dir))
+	defer reloaded.Close()
+	val, exists := reloaded.Get("key")
+	if val != nil || exists {
+		t.Fatalf(`Get("key") = %v, %v after reload, but this entry should have been evicted from disk by now.`, val, exists)
+	}
+}
+
+func TestCacheMaxEntries(t *testing.T) {
+	c := New(1*time.Minute, WithMaxEntries(2))
+	defer c.Close()
+	c.Add("a", []byte{1})
+	c.Add("b", []byte{2})
+	// Touch "a" so that "b" becomes the least recently used entry
+	c.Get("a")
+	c.Add("c", []byte{3})
+
+	if _, exists := c.Get("b"); exists {
+		t.Fatalf(`Get("b") found an entry, but it should have been evicted as least recently used.`)
+	}
+	for _, key := range []string{"a", "c"} {
+		if _, exists := c.Get(key); !exists {
+			t.Fatalf(`Get(%q) found no entry, but it should still be cached.`, key)
+		}
+	}
+}
+
+func TestCacheMaxBytes(t *testing.T) {
+	c := New(1*time.Minute, WithMaxBytes(4))
+	defer c.Close()
+	c.Add("a", []byte{1, 2})
+	c.Add("b", []byte{3, 4})
+	c.Add("c", []byte{5})
+
+	if _, exists := c.Get("a"); exists {
+		t.Fatalf(`Get("a") found an entry, but it should have been evicted to stay within 4 bytes.`)
+	}
+
+	c.Add("big", []byte{1, 2, 3, 4, 5})
+	if _, exists := c.Get("big"); exists {
+		t.Fatalf(`Get("big") found an entry, but values larger than the cache should not be stored.`)
+	}
+}
+
+func TestCacheTTL(t *testing.T) {
+	clock := newFakeClock()
+	c := New(1*time.Minute, WithClock(clock))
+	defer c.Close()
+	c.AddWithTTL("short", []byte{1}, 10*time.Millisecond)
+	c.AddWithTTL("long", []byte{2}, 1*time.Minute)
+	clock.Advance(20 * time.Millisecond)
+
+	if val, exists := c.Get("short"); val != nil || exists {
+		t.Fatalf(`Get("short") = %v, %v, but this entry should have expired by now.`, val, exists)
+	}
+	if _, exists := c.Get("long"); !exists {
+		t.Fatalf(`Get("long") found no entry, but it should not have expired yet.`)
+	}
+}
+
+func TestCacheStaleWhileRevalidate(t *testing.T) {
+	clock := newFakeClock()
+	c := New(1*time.Minute, WithStaleWhileRevalidate(1*time.Minute), WithClock(clock))
+	defer c.Close()
+	want := []byte{1}
+	c.AddWithTTL("key", want, 10*time.Millisecond)
+
+	val, stale, exists := c.Lookup("key")
+	if !exists || stale {
+		t.Fatalf(`Lookup("key") = %v, %v, %v, want %v, false, true.`, val, stale, exists, want)
+	}
+
+	clock.Advance(20 * time.Millisecond)
+	val, stale, exists = c.Lookup("key")
+	if !exists || !stale || string(val) != string(want) {
+		t.Fatalf(`Lookup("key") = %v, %v, %v, want %v, true, true.`, val, stale, exists, want)
+	}
+	if val, exists := c.Get("key"); val != nil || exists {
+		t.Fatalf(`Get("key") = %v, %v, but Get should not return stale entries.`, val, exists)
+	}
+
+	clock.Advance(2 * time.Minute)
+	if val, _, exists := c.Lookup("key"); val != nil || exists {
+		t.Fatalf(`Lookup("key") = %v, %v, but the entry should be gone once the stale window has passed.`, val, exists)
+	}
+}
+
+func TestCachePeekExpired(t *testing.T) {
+	clock := newFakeClock()
+	c := New(1*time.Hour, WithClock(clock))
+	c.AddWithTTL("key", []byte{1}, time.Minute)
+	clock.Advance(2 * time.Minute)
+
+	if val, _, exists := c.Lookup("key"); val != nil || exists {
+		t.Fatalf(`Lookup("key") = %v, %v for an expired entry without a stale window, want a miss.`, val, exists)
+	}
+	if val, stale, exists := c.Peek("key"); len(val) != 1 || !stale || !exists {
+		t.Fatalf(`Peek("key") = %v, %v, %v before the entry is reaped, want [1], true, true.`, val, stale, exists)
+	}
+
+	clock.Advance(1 * time.Hour)
+	c.Close()
+	if val, _, exists := c.Peek("key"); val != nil || exists {
+		t.Fatalf(`Peek("key") = %v, %v after the entry was reaped, want a miss.`, val, exists)
+	}
+}
+
+func TestCacheStats(t *testing.T) {
+	c := New(1*time.Minute, WithMaxEntries(1))
+	defer c.Close()
+	c.Add("a", []byte{1, 2})
+	c.Get("a")
+	c.Get("missing")
+	c.Add("b", []byte{3})
+	// Peeking is not a use of the cache
+	c.Peek("b")
+	c.Peek("missing")
+
+	want := Stats{Hits: 1, Misses: 1, Evictions: 1, Entries: 1, Bytes: 1}
+	if got := c.Stats(); got != want {
+		t.Fatalf(`Stats() = %+v, want %+v.`, got, want)
+	}
+
+	if !c.Evict("b") || c.Evict("b") {
+		t.Fatalf(`Evict("b") should report true exactly once.`)
+	}
+	c.Add("c", []byte{4})
+	c.Clear()
+	if got := c.Stats(); got.Entries != 0 || got.Bytes != 0 {
+		t.Fatalf(`Stats() = %+v after Clear(), want no entries.`, got)
+	}
+}
+
+func TestConformance(t *testing.T) {
+	t.Run("Memory", func(t *testing.T) {
+		cachetest.Run(t, func(t *testing.T) pokeapi.Cache {
+			c := New(1 * time.Minute)
+			t.Cleanup(c.Close)
+			return c
+		}, cachetest.Options{})
+	})
+	t.Run("Disk", func(t *testing.T) {
+		cachetest.Run(t, func(t *testing.T) pokeapi.Cache {
+			c := New(1*time.Minute, WithDir(t.TempDir()))
+			t.Cleanup(c.Close)
+			return c
+		}, cachetest.Options{})
+	})
+	t.Run("Nop", func(t *testing.T) {
+		cachetest.Run(t, func(t *testing.T) pokeapi.Cache {
+			return Nop{}
+		}, cachetest.Options{Lossy: true})
+	})
+}
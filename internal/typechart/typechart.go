@@ -0,0 +1,82 @@
+// Package typechart calculates how effective attacks of each type are against Pokemon.
+package typechart
+
+import (
+	"sort"
+
+	"github.com/madsbv/pokerepl/internal/pokeapi"
+)
+
+// AttackingTypes lists the types that moves can have, in the order the games list them.
+var AttackingTypes = []string{
+	"normal", "fire", "water", "electric", "grass", "ice",
+	"fighting", "poison", "ground", "flying", "psychic", "bug",
+	"rock", "ghost", "dragon", "dark", "steel", "fairy",
+}
+
+// Multipliers returns the damage multiplier of every attacking type against a
+// Pokemon with the given types. The multipliers of the individual types are
+// multiplied, so a dual-type Pokemon can take 4x, 0.25x or no damage.
+func Multipliers(defending []pokeapi.PokeapiType) map[string]float64 {
+	multipliers := make(map[string]float64, len(AttackingTypes))
+	for _, t := range AttackingTypes {
+		multipliers[t] = 1
+	}
+	for _, d := range defending {
+		for _, t := range d.DamageRelations.DoubleDamageFrom {
+			multipliers[t.Name] *= 2
+		}
+		for _, t := range d.DamageRelations.HalfDamageFrom {
+			multipliers[t.Name] *= 0.5
+		}
+		for _, t := range d.DamageRelations.NoDamageFrom {
+			multipliers[t.Name] = 0
+		}
+	}
+	return multipliers
+}
+
+// A Group lists the attacking types that share a multiplier.
+type Group struct {
+	Multiplier float64
+	Types      []string
+}
+
+// Groups sorts multipliers into groups of types with the same multiplier,
+// from most to least effective. Types are listed in the order of AttackingTypes,
+// followed by any unknown types in alphabetical order.
+func Groups(multipliers map[string]float64) []Group {
+	order := make(map[string]int, len(AttackingTypes))
+	for i, t := range AttackingTypes {
+		order[t] = i
+	}
+	types := make([]string, 0, len(multipliers))
+	for t := range multipliers {
+		types = append(types, t)
+	}
+	sort.Slice(types, func(i, j int) bool {
+		oi, iKnown := order[types[i]]
+		oj, jKnown := order[types[j]]
+		if iKnown != jKnown {
+			return iKnown
+		}
+		if iKnown {
+			return oi < oj
+		}
+		return types[i] < types[j]
+	})
+
+	byMultiplier := make(map[float64][]string)
+	for _, t := range types {
+		m := multipliers[t]
+		byMultiplier[m] = append(byMultiplier[m], t)
+	}
+	groups := make([]Group, 0, len(byMultiplier))
+	for m, types := range byMultiplier {
+		groups = append(groups, Group{m, types})
+	}
+	sort.Slice(groups, func(i, j int) bool {
+		return groups[i].Multiplier > groups[j].Multiplier
+	})
+	return groups
+}
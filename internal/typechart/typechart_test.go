@@ -0,0 +1,55 @@
+package typechart
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+
+	"github.com/madsbv/pokerepl/internal/pokeapi"
+)
+
+func parseType(t *testing.T, data string) pokeapi.PokeapiType {
+	t.Helper()
+	var pt pokeapi.PokeapiType
+	if err := json.Unmarshal([]byte(data), &pt); err != nil {
+		t.Fatalf("Failed to parse type: %v", err)
+	}
+	return pt
+}
+
+func TestMultipliersDualType(t *testing.T) {
+	// Abridged damage relations of the types of Gyarados
+	water := parseType(t, `{"name": "water", "damage_relations": {
+		"double_damage_from": [{"name": "electric"}, {"name": "grass"}],
+		"half_damage_from": [{"name": "fire"}, {"name": "water"}, {"name": "ice"}, {"name": "steel"}]
+	}}`)
+	flying := parseType(t, `{"name": "flying", "damage_relations": {
+		"double_damage_from": [{"name": "electric"}, {"name": "ice"}, {"name": "rock"}],
+		"half_damage_from": [{"name": "grass"}, {"name": "fighting"}, {"name": "bug"}],
+		"no_damage_from": [{"name": "ground"}]
+	}}`)
+
+	m := Multipliers([]pokeapi.PokeapiType{water, flying})
+	want := map[string]float64{
+		"electric": 4, "rock": 2, "grass": 1, "ice": 1, "normal": 1,
+		"fire": 0.5, "water": 0.5, "steel": 0.5, "fighting": 0.5, "bug": 0.5, "ground": 0,
+	}
+	for typ, w := range want {
+		if m[typ] != w {
+			t.Errorf("Multipliers()[%q] = %v, want %v.", typ, m[typ], w)
+		}
+	}
+}
+
+func TestGroups(t *testing.T) {
+	groups := Groups(map[string]float64{"electric": 4, "fire": 0.5, "ground": 0, "normal": 1, "water": 0.5})
+	want := []Group{
+		{4, []string{"electric"}},
+		{1, []string{"normal"}},
+		{0.5, []string{"fire", "water"}},
+		{0, []string{"ground"}},
+	}
+	if !reflect.DeepEqual(groups, want) {
+		t.Fatalf("Groups() = %v, want %v.", groups, want)
+	}
+}
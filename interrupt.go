@@ -0,0 +1,50 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"sync"
+)
+
+// An interrupter cancels the running command when the user presses Ctrl-C,
+// instead of letting the signal terminate the REPL.
+type interrupter struct {
+	mux sync.Mutex
+	// Cancels the context of the running command, or nil at the prompt
+	cancel context.CancelFunc
+}
+
+// listen handles the signals sent on signals until it is closed.
+func (i *interrupter) listen(signals <-chan os.Signal, prompt string) {
+	for range signals {
+		i.mux.Lock()
+		if i.cancel != nil {
+			i.cancel()
+		} else {
+			fmt.Printf("\nType exit to quit\n%s", prompt)
+		}
+		i.mux.Unlock()
+	}
+}
+
+// run calls f with a context that is cancelled if the user presses Ctrl-C before f returns.
+func (i *interrupter) run(f func(ctx context.Context)) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	i.mux.Lock()
+	i.cancel = cancel
+	i.mux.Unlock()
+
+	defer func() {
+		i.mux.Lock()
+		i.cancel = nil
+		i.mux.Unlock()
+	}()
+
+	f(ctx)
+	if ctx.Err() != nil {
+		fmt.Println("Interrupted")
+	}
+}
@@ -0,0 +1,217 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+
+	"github.com/madsbv/pokerepl/internal/pokeapi"
+)
+
+// Number of items shown per page when listing an item category
+const itemPageSize = 20
+
+// itemPager tracks the position of items/itemsb, either in the list of all
+// items or in the items of a single category.
+type itemPager struct {
+	// Category being listed, or "" for all items
+	category string
+	// Position among all items
+	all *pokeapi.Pager[pokeapi.PokeapiItem]
+	// Items of category, and the offset of the page shown last
+	categoryItems []pokeapi.NamedAPIResource[pokeapi.PokeapiItem]
+	offset        int
+}
+
+func commandItem(ctx context.Context, c *config, args []string) {
+	if len(args) == 0 {
+		fmt.Println("Enter the name of an item to look it up")
+		return
+	}
+	name := c.resolveName(ctx, "item", args[0])
+
+	item, err := c.client.GetItem(ctx, name)
+	if errors.Is(err, pokeapi.ErrNotFound) {
+		fmt.Printf("There is no item called %v\n", name)
+		return
+	} else if err != nil {
+		fmt.Printf("Something went wrong while looking up %v: %v\n", name, err)
+		return
+	}
+
+	fmt.Printf("Name: %v\n", c.localize("item", item.Name, item.Names))
+	fmt.Printf("Category: %v\n", item.Category.Name)
+	fmt.Printf("Cost: %v\n", item.Cost)
+	if item.FlingPower != nil {
+		fmt.Printf("Fling power: %v\n", *item.FlingPower)
+	}
+	if effect := itemEffect(item, c.lang); effect != "" {
+		fmt.Printf("Effect: %v\n", effect)
+	}
+	if len(item.HeldByPokemon) > 0 {
+		fmt.Println("Held by:")
+		for _, p := range item.HeldByPokemon {
+			fmt.Printf("  - %v\n", c.knownName("pokemon-species", p.Pokemon.Name))
+		}
+	}
+}
+
+// itemEffect returns the short effect text of item in lang, falling back to fallbackLang.
+func itemEffect(item pokeapi.PokeapiItem, lang string) string {
+	for _, l := range []string{lang, fallbackLang} {
+		for _, e := range item.EffectEntries {
+			if e.Language.Name == l {
+				return cleanFlavorText(e.ShortEffect)
+			}
+		}
+	}
+	return ""
+}
+
+// commandItems shows the next page of items. With an argument, it starts listing
+// the items of that category instead, or all items again if the argument is "all".
+func commandItems(ctx context.Context, c *config, args []string) {
+	if len(args) > 0 {
+		// Keep the current position until the category is known to exist
+		items := itemPager{all: c.items.all}
+		if args[0] != "all" {
+			if err := items.startCategory(ctx, c, args[0]); err != nil {
+				return
+			}
+		}
+		items.all.Reset()
+		c.items = items
+	}
+	if c.items.category != "" {
+		c.items.printCategoryPage(c, c.items.offset+itemPageSize)
+		return
+	}
+	printItemsPage(ctx, c, false)
+}
+
+// commandItemsb shows the previous page of items.
+func commandItemsb(ctx context.Context, c *config, _ []string) {
+	if c.items.category != "" {
+		c.items.printCategoryPage(c, c.items.offset-itemPageSize)
+		return
+	}
+	printItemsPage(ctx, c, true)
+}
+
+func printItemsPage(ctx context.Context, c *config, back bool) {
+	p, err := turnPage(ctx, c.items.all, back)
+	if err != nil {
+		fmt.Printf("Error: %s\n", err)
+		return
+	}
+	for _, item := range p.Results {
+		fmt.Println(listedItemName(c, item))
+	}
+}
+
+func (p *itemPager) startCategory(ctx context.Context, c *config, name string) error {
+	category, err := c.client.GetItemCategory(ctx, name)
+	if errors.Is(err, pokeapi.ErrNotFound) {
+		fmt.Printf("There is no item category called %v\n", name)
+		return err
+	} else if err != nil {
+		fmt.Printf("Something went wrong while looking up %v: %v\n", name, err)
+		return err
+	}
+	p.category = category.Name
+	p.offset = -itemPageSize
+	p.categoryItems = append(p.categoryItems, category.Items...)
+	sort.Slice(p.categoryItems, func(i, j int) bool {
+		return p.categoryItems[i].Name < p.categoryItems[j].Name
+	})
+	return nil
+}
+
+// printCategoryPage prints the page of category items starting at offset.
+// Like map, paging past either end starts over from the first page.
+func (p *itemPager) printCategoryPage(c *config, offset int) {
+	if offset < 0 || offset >= len(p.categoryItems) {
+		offset = 0
+	}
+	end := min(offset+itemPageSize, len(p.categoryItems))
+	for _, item := range p.categoryItems[offset:end] {
+		fmt.Println(listedItemName(c, item))
+	}
+	p.offset = offset
+}
+
+func commandBerry(ctx context.Context, c *config, args []string) {
+	if len(args) == 0 {
+		fmt.Println("Enter the name of a berry, or of a flavor like spicy, to look it up")
+		return
+	}
+	name := c.resolveName(ctx, "berry-flavor", args[0])
+
+	berry, err := c.client.GetBerry(ctx, name)
+	if errors.Is(err, pokeapi.ErrNotFound) {
+		printBerryFlavor(ctx, c, name)
+		return
+	} else if err != nil {
+		fmt.Printf("Something went wrong while looking up %v: %v\n", name, err)
+		return
+	}
+
+	fmt.Printf("Name: %v\n", berryName(c, berry))
+	fmt.Printf("Item: %v\n", listedItemName(c, berry.Item))
+	fmt.Printf("Firmness: %v\n", berry.Firmness.Name)
+	fmt.Printf("Size: %vmm\n", berry.Size)
+	fmt.Printf("Growth time: %v hours per stage\n", berry.GrowthTime)
+	fmt.Printf("Max harvest: %v\n", berry.MaxHarvest)
+	fmt.Printf("Natural Gift: %v type, %v power\n", berry.NaturalGiftType.Name, berry.NaturalGiftPower)
+	fmt.Println("Flavors:")
+	for _, f := range berry.Flavors {
+		if f.Potency > 0 {
+			fmt.Printf("  - %v: %v\n", f.Flavor.Name, f.Potency)
+		}
+	}
+}
+
+// printBerryFlavor lists the berries with the flavor called name, most potent first.
+func printBerryFlavor(ctx context.Context, c *config, name string) {
+	flavor, err := c.client.GetBerryFlavor(ctx, name)
+	if errors.Is(err, pokeapi.ErrNotFound) {
+		fmt.Printf("There is no berry or berry flavor called %v\n", name)
+		return
+	} else if err != nil {
+		fmt.Printf("Something went wrong while looking up %v: %v\n", name, err)
+		return
+	}
+
+	berries := flavor.Berries
+	sort.SliceStable(berries, func(i, j int) bool {
+		return berries[i].Potency > berries[j].Potency
+	})
+	fmt.Printf("Berries with a %v flavor:\n", c.localize("berry-flavor", flavor.Name, flavor.Names))
+	for _, b := range berries {
+		if b.Potency > 0 {
+			fmt.Printf("  - %v: %v\n", listedBerryName(c, b.Berry), b.Potency)
+		}
+	}
+}
+
+// berryName returns the name of berry in the configured language. Berries
+// are only named after their item in other languages, which is used if it is known.
+func berryName(c *config, berry pokeapi.PokeapiBerry) string {
+	if name := listedItemName(c, berry.Item); name != berry.Item.Name {
+		return name
+	}
+	return berry.Name
+}
+
+// listedBerryName is like berryName, but for berries that may not be cached.
+func listedBerryName(c *config, b pokeapi.NamedAPIResource[pokeapi.PokeapiBerry]) string {
+	if c.lang == fallbackLang {
+		return b.Name
+	}
+	berry, ok := b.Cached(c.client)
+	if !ok {
+		return b.Name
+	}
+	return berryName(c, berry)
+}
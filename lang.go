@@ -0,0 +1,251 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/madsbv/pokerepl/internal/pokeapi"
+)
+
+// Language that text is shown in when it is not available in the configured language
+const fallbackLang = "en"
+
+func commandSet(ctx context.Context, c *config, args []string) {
+	if len(args) == 0 || args[0] != "lang" {
+		fmt.Println("Usage: set lang <language>, e.g. set lang de")
+		return
+	}
+	if len(args) == 1 {
+		fmt.Printf("The language is %v\n", c.lang)
+		return
+	}
+	lang := args[1]
+
+	languages, err := pokeapi.ListAll[pokeapi.Resource](ctx, c.client, "language", 0)
+	if err != nil {
+		fmt.Printf("Something went wrong while looking up languages: %v\n", err)
+		return
+	}
+	names := make([]string, 0, len(languages))
+	for _, l := range languages {
+		if l.Name == lang {
+			c.lang = lang
+			fmt.Printf("Language set to %v\n", lang)
+			if lang != fallbackLang {
+				fmt.Printf("Names in %v can be entered once they have been shown, e.g. by explore or species\n", lang)
+			}
+			return
+		}
+		names = append(names, l.Name)
+	}
+	fmt.Printf("There is no language called %v, try one of %v\n", lang, strings.Join(names, ", "))
+}
+
+// localizedName returns the name in lang from names, or slug if there is none.
+// Slugs are in English, so they are shown as they are in the fallback language.
+func localizedName(names []pokeapi.Name, lang string, slug string) string {
+	if lang == fallbackLang {
+		return slug
+	}
+	for _, n := range names {
+		if n.Language.Name == lang {
+			return n.Name
+		}
+	}
+	return slug
+}
+
+// localize returns the name of the resource of kind called slug in the
+// configured language, remembering its names so that resolveName can find it.
+func (c *config) localize(kind string, slug string, names []pokeapi.Name) string {
+	c.names.learn(kind, slug, names)
+	return localizedName(names, c.lang, slug)
+}
+
+// knownName returns the name of the resource of kind called slug in the
+// configured language, if its names have been seen before, or slug otherwise.
+func (c *config) knownName(kind string, slug string) string {
+	return localizedName(c.names.known(kind, slug), c.lang, slug)
+}
+
+// resourceName returns the name of r, a resource of kind, in the configured language.
+// r is only fetched if the language is not the fallback language, and its slug is used if that fails.
+func resourceName[T any](ctx context.Context, c *config, kind string, r pokeapi.NamedAPIResource[T], names func(T) []pokeapi.Name) string {
+	if c.lang == fallbackLang {
+		return r.Name
+	}
+	resource, err := r.Resolve(ctx, c.client)
+	if err != nil {
+		return r.Name
+	}
+	return c.localize(kind, r.Name, names(resource))
+}
+
+// listedName is like resourceName, but for resources listed in bulk. Rather than
+// spending a request on each of them, it only uses names that are known or cached already.
+func listedName[T any](c *config, kind string, r pokeapi.NamedAPIResource[T], names func(T) []pokeapi.Name) string {
+	if c.lang == fallbackLang {
+		return r.Name
+	}
+	if resource, ok := r.Cached(c.client); ok {
+		c.names.learn(kind, r.Name, names(resource))
+	}
+	return c.knownName(kind, r.Name)
+}
+
+// pokemonName returns the name of the Pokemon called slug in the configured language.
+// Only species have localized names, so varieties like deoxys-attack keep their slug.
+func pokemonName(ctx context.Context, c *config, slug string) string {
+	if c.lang == fallbackLang {
+		return slug
+	}
+	species, err := lookupSpecies(ctx, c, slug)
+	if err != nil || species.Name != slug {
+		return slug
+	}
+	return c.localize("pokemon-species", slug, species.Names)
+}
+
+func listedAreaName(c *config, area pokeapi.NamedAPIResource[pokeapi.PokeapiLocation]) string {
+	return listedName(c, "location-area", area, func(l pokeapi.PokeapiLocation) []pokeapi.Name {
+		return l.Names
+	})
+}
+
+func listedSpeciesName(c *config, species pokeapi.NamedAPIResource[pokeapi.PokeapiSpecies]) string {
+	return listedName(c, "pokemon-species", species, func(s pokeapi.PokeapiSpecies) []pokeapi.Name {
+		return s.Names
+	})
+}
+
+func listedPlaceName(c *config, place pokeapi.NamedAPIResource[pokeapi.PokeapiPlace]) string {
+	return listedName(c, "location", place, func(p pokeapi.PokeapiPlace) []pokeapi.Name {
+		return p.Names
+	})
+}
+
+func listedMoveName(c *config, move pokeapi.NamedAPIResource[pokeapi.PokeapiMove]) string {
+	return listedName(c, "move", move, func(m pokeapi.PokeapiMove) []pokeapi.Name {
+		return m.Names
+	})
+}
+
+func listedItemName(c *config, item pokeapi.NamedAPIResource[pokeapi.PokeapiItem]) string {
+	return listedName(c, "item", item, func(i pokeapi.PokeapiItem) []pokeapi.Name {
+		return i.Names
+	})
+}
+
+func regionName(ctx context.Context, c *config, region pokeapi.NamedAPIResource[pokeapi.PokeapiRegion]) string {
+	return resourceName(ctx, c, "region", region, func(r pokeapi.PokeapiRegion) []pokeapi.Name {
+		return r.Names
+	})
+}
+
+func typeName(ctx context.Context, c *config, t pokeapi.NamedAPIResource[pokeapi.PokeapiType]) string {
+	return resourceName(ctx, c, "type", t, func(t pokeapi.PokeapiType) []pokeapi.Name {
+		return t.Names
+	})
+}
+
+// resolveName returns the slug of the resource of kind with the given name in
+// any language, e.g. "pallet-town" for the location "Alabastia". Names that
+// are not known are assumed to be slugs, or English names that match them.
+func (c *config) resolveName(ctx context.Context, kind string, name string) string {
+	if slug, ok := c.names.lookup(kind, name); ok {
+		return slug
+	}
+	index, ok := smallLists[kind]
+	if !ok || c.names.complete[kind] || c.lang == fallbackLang {
+		return normalizeName(name)
+	}
+	// Failing to index the list only means that fewer names can be resolved
+	if err := index(ctx, c); err == nil {
+		c.names.complete[kind] = true
+	}
+	if slug, ok := c.names.lookup(kind, name); ok {
+		return slug
+	}
+	return normalizeName(name)
+}
+
+// Resources of which there are few enough to fetch all of them when a name can't be resolved
+var smallLists = map[string]func(context.Context, *config) error{
+	"region": indexList("region", func(r pokeapi.PokeapiRegion) []pokeapi.Name {
+		return r.Names
+	}),
+}
+
+// indexList returns a function that fetches every resource at the list endpoint
+// of kind and adds their names to the name index.
+func indexList[T any](kind string, names func(T) []pokeapi.Name) func(context.Context, *config) error {
+	return func(ctx context.Context, c *config) error {
+		list, err := pokeapi.ListAll[T](ctx, c.client, kind, 0)
+		if err != nil {
+			return err
+		}
+		for _, r := range list {
+			resource, err := r.Resolve(ctx, c.client)
+			if err != nil {
+				return err
+			}
+			c.names.learn(kind, r.Name, names(resource))
+		}
+		return nil
+	}
+}
+
+// A nameIndex maps the localized names of resources to their slugs, and back.
+// Names are learnt from the resources fetched along the way.
+type nameIndex struct {
+	// Slugs by kind of resource, e.g. "region", and normalized name
+	slugs map[string]map[string]string
+	// Names by kind of resource and slug
+	names map[string]map[string][]pokeapi.Name
+	// Kinds of resources of which all names have been learnt
+	complete map[string]bool
+}
+
+func newNameIndex() nameIndex {
+	return nameIndex{
+		slugs:    make(map[string]map[string]string),
+		names:    make(map[string]map[string][]pokeapi.Name),
+		complete: make(map[string]bool),
+	}
+}
+
+// learn adds the names of the resource of kind called slug, in every language.
+func (idx nameIndex) learn(kind string, slug string, names []pokeapi.Name) {
+	slugs, ok := idx.slugs[kind]
+	if !ok {
+		slugs = make(map[string]string)
+		idx.slugs[kind] = slugs
+	}
+	for _, n := range names {
+		slugs[normalizeName(n.Name)] = slug
+	}
+
+	byKind, ok := idx.names[kind]
+	if !ok {
+		byKind = make(map[string][]pokeapi.Name)
+		idx.names[kind] = byKind
+	}
+	byKind[slug] = names
+}
+
+// known returns the names of the resource of kind called slug, or nil if it hasn't been learnt.
+func (idx nameIndex) known(kind string, slug string) []pokeapi.Name {
+	return idx.names[kind][slug]
+}
+
+func (idx nameIndex) lookup(kind string, name string) (string, bool) {
+	slug, ok := idx.slugs[kind][normalizeName(name)]
+	return slug, ok
+}
+
+// normalizeName makes names comparable regardless of case, and lets names with
+// spaces, which can't be entered as a single argument, be entered like slugs.
+func normalizeName(name string) string {
+	return strings.ToLower(strings.Join(strings.Fields(name), "-"))
+}
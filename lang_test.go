@@ -0,0 +1,84 @@
+package main
+
+import (
+	"testing"
+
+	"github.com/madsbv/pokerepl/internal/pokeapi"
+)
+
+func testName(lang string, n string) pokeapi.Name {
+	return pokeapi.Name{Name: n, Language: pokeapi.NamedAPIResource[pokeapi.Resource]{Name: lang}}
+}
+
+func TestNormalizeName(t *testing.T) {
+	tests := []struct {
+		name string
+		want string
+	}{
+		{"pallet-town", "pallet-town"},
+		{"Pallet Town", "pallet-town"},
+		{"  Route   1 ", "route-1"},
+		{"Mr. Mime", "mr.-mime"},
+		{"ALABASTIA", "alabastia"},
+		{"", ""},
+	}
+	for _, tt := range tests {
+		if got := normalizeName(tt.name); got != tt.want {
+			t.Errorf(`normalizeName(%q) = %q, want %q.`, tt.name, got, tt.want)
+		}
+	}
+}
+
+func TestLocalizedName(t *testing.T) {
+	names := []pokeapi.Name{testName("de", "Alabastia"), testName("en", "Pallet Town")}
+	tests := []struct {
+		names []pokeapi.Name
+		lang  string
+		want  string
+	}{
+		{names, "de", "Alabastia"},
+		// Slugs are shown in the fallback language, even if there is a name in it
+		{names, "en", "pallet-town"},
+		{names, "fr", "pallet-town"},
+		{nil, "de", "pallet-town"},
+	}
+	for _, tt := range tests {
+		if got := localizedName(tt.names, tt.lang, "pallet-town"); got != tt.want {
+			t.Errorf(`localizedName(%v, %q, "pallet-town") = %q, want %q.`, tt.names, tt.lang, got, tt.want)
+		}
+	}
+}
+
+func TestNameIndex(t *testing.T) {
+	idx := newNameIndex()
+	town := []pokeapi.Name{testName("de", "Alabastia"), testName("en", "Pallet Town")}
+	idx.learn("location-area", "pallet-town", town)
+	idx.learn("region", "kanto", []pokeapi.Name{testName("de", "Kanto")})
+
+	tests := []struct {
+		kind   string
+		name   string
+		want   string
+		wantOk bool
+	}{
+		{"location-area", "Alabastia", "pallet-town", true},
+		{"location-area", "alabastia", "pallet-town", true},
+		{"location-area", "pallet town", "pallet-town", true},
+		{"location-area", "Kanto", "", false},
+		{"region", "kanto", "kanto", true},
+		{"region", "Johto", "", false},
+		{"pokemon-species", "Alabastia", "", false},
+	}
+	for _, tt := range tests {
+		if got, ok := idx.lookup(tt.kind, tt.name); got != tt.want || ok != tt.wantOk {
+			t.Errorf(`lookup(%q, %q) = %q, %v, want %q, %v.`, tt.kind, tt.name, got, ok, tt.want, tt.wantOk)
+		}
+	}
+
+	if got := idx.known("location-area", "pallet-town"); len(got) != len(town) || got[0] != town[0] {
+		t.Errorf(`known("location-area", "pallet-town") = %v, want %v.`, got, town)
+	}
+	if got := idx.known("location-area", "viridian-city"); got != nil {
+		t.Errorf(`known("location-area", "viridian-city") = %v, want nil.`, got)
+	}
+}
@@ -2,9 +2,15 @@ package main
 
 import (
 	"bufio"
+	"context"
+	"errors"
+	"flag"
 	"fmt"
 	"math/rand"
 	"os"
+	"os/signal"
+	"path/filepath"
+	"sort"
 	"strings"
 	"time"
 
@@ -13,17 +19,71 @@ import (
 )
 
 func main() {
+	baseURL := os.Getenv("POKEAPI_BASE_URL")
+	if baseURL == "" {
+		baseURL = pokeapi.DefaultBaseURL
+	}
+	flag.StringVar(&baseURL, "base-url", baseURL, "Base URL of the PokeAPI instance to use, including the API version. Can also be set with POKEAPI_BASE_URL.")
+	rateLimit := flag.Float64("rate-limit", 10, "Maximum number of requests per second to send to PokeAPI, to respect its fair use policy. 0 disables the limit.")
+	flag.Parse()
+
 	prompt := "pokedex > "
 	scanner := bufio.NewScanner(os.Stdin)
 	cacheInterval := 60 * 5 * time.Second
-	config := config{nil, nil, true, pokecache.New(cacheInterval), make(map[string]pokeapi.PokeapiPokemon)}
+	cacheOpts := []pokecache.Option{
+		// Pokemon documents are large, so keep the cache from growing without bounds
+		pokecache.WithMaxBytes(64 << 20),
+		// Serve expired entries while they are refreshed in the background, to keep the REPL responsive
+		pokecache.WithStaleWhileRevalidate(30 * 24 * time.Hour),
+	}
+	if dir, err := os.UserCacheDir(); err == nil {
+		cacheOpts = append(cacheOpts, pokecache.WithDir(filepath.Join(dir, "pokerepl")))
+	}
+	cache := pokecache.New(cacheInterval, cacheOpts...)
+	defer cache.Close()
+
+	clientOpts := []pokeapi.Option{pokeapi.WithBaseURL(baseURL)}
+	if *rateLimit > 0 {
+		clientOpts = append(clientOpts,
+			pokeapi.WithRateLimit(*rateLimit, max(1, int(*rateLimit))),
+			pokeapi.WithDelayNotifier(func(url string, d time.Duration) {
+				fmt.Printf("Rate limited, waiting %v before requesting %v\n", d.Round(time.Millisecond), url)
+			}),
+		)
+	}
+	client := pokeapi.NewClient(cache, clientOpts...)
+	config := config{
+		locations: newLocationPager(),
+		running:   true,
+		cache:     cache,
+		client:    client,
+		lang:      fallbackLang,
+		names:     newNameIndex(),
+		pokeman:   make(map[string]pokeapi.PokeapiPokemon),
+		items:     itemPager{all: pokeapi.List[pokeapi.PokeapiItem](client, "item", 0, 0)},
+	}
+	signals := make(chan os.Signal, 1)
+	signal.Notify(signals, os.Interrupt)
+	var interrupts interrupter
+	go interrupts.listen(signals, prompt)
+
 	for {
 		fmt.Print(prompt)
-		scanner.Scan()
+		if !scanner.Scan() {
+			// The input has ended, so there are no more commands to wait for
+			if err := scanner.Err(); err != nil {
+				fmt.Printf("\nError reading input: %v\n", err)
+			} else {
+				fmt.Println()
+			}
+			break
+		}
 		input := scanner.Text()
 		args := strings.Split(input, " ")
 		cmd := args[0]
-		commands(cmd).callback(&config, args[1:])
+		interrupts.run(func(ctx context.Context) {
+			commands(cmd).callback(ctx, &config, args[1:])
+		})
 		if !config.running {
 			break
 		}
@@ -33,11 +93,28 @@ func main() {
 type command struct {
 	name        string
 	description string
-	callback    func(*config, []string)
+	callback    func(context.Context, *config, []string)
 }
 
 func commands(input string) command {
-	commands := map[string]command{
+	commands := allCommands()
+
+	// Command aliases
+	if input == "q" {
+		input = "exit"
+	}
+
+	com, ok := commands[input]
+	if ok {
+		return com
+	} else {
+		fmt.Println("Unknown command")
+		return commands["help"]
+	}
+}
+
+func allCommands() map[string]command {
+	return map[string]command{
 		"help": {
 			name:        "help",
 			description: "Displays this help message",
@@ -50,7 +127,7 @@ func commands(input string) command {
 		},
 		"map": {
 			name:        "map",
-			description: "Go forwards and display map",
+			description: "Go forwards and display map: map [<page>|first|last] [--limit <page size>]",
 			callback:    commandMap,
 		},
 		"mapb": {
@@ -58,9 +135,24 @@ func commands(input string) command {
 			description: "Go back and display map",
 			callback:    commandMapb,
 		},
+		"regions": {
+			name:        "regions",
+			description: "List the regions",
+			callback:    commandRegions,
+		},
+		"region": {
+			name:        "region",
+			description: "List the locations in a region",
+			callback:    commandRegion,
+		},
+		"location": {
+			name:        "location",
+			description: "List the areas in a location",
+			callback:    commandLocation,
+		},
 		"explore": {
 			name:        "explore",
-			description: "Explore an area",
+			description: "Explore an area: explore <area> [--version <version>] [--method <method>]",
 			callback:    commandExplore,
 		},
 		"catch": {
@@ -78,96 +170,137 @@ func commands(input string) command {
 			description: "List the Pokemon you have caught",
 			callback:    commandPokedex,
 		},
-	}
-
-	// Command aliases
-	if input == "q" {
-		input = "exit"
-	}
-
-	com, ok := commands[input]
-	if ok {
-		return com
-	} else {
-		fmt.Println("Unknown command")
-		return commands["help"]
+		"species": {
+			name:        "species",
+			description: "Look up a Pokemon species",
+			callback:    commandSpecies,
+		},
+		"evolutions": {
+			name:        "evolutions",
+			description: "Show the evolution chain of a Pokemon",
+			callback:    commandEvolutions,
+		},
+		"weakness": {
+			name:        "weakness",
+			description: "Show how effective each attacking type is against a Pokemon",
+			callback:    commandWeakness,
+		},
+		"move": {
+			name:        "move",
+			description: "Look up a move",
+			callback:    commandMove,
+		},
+		"moves": {
+			name:        "moves",
+			description: "List the moves a Pokemon learns: moves <pokemon> [version-group]",
+			callback:    commandMoves,
+		},
+		"ability": {
+			name:        "ability",
+			description: "Look up an ability",
+			callback:    commandAbility,
+		},
+		"item": {
+			name:        "item",
+			description: "Look up an item",
+			callback:    commandItem,
+		},
+		"items": {
+			name:        "items",
+			description: "Go forwards and list items: items [category|all]",
+			callback:    commandItems,
+		},
+		"itemsb": {
+			name:        "itemsb",
+			description: "Go back and list items",
+			callback:    commandItemsb,
+		},
+		"berry": {
+			name:        "berry",
+			description: "Look up a berry, or the berries with a flavor",
+			callback:    commandBerry,
+		},
+		"where": {
+			name:        "where",
+			description: "List the areas where a Pokemon can be found",
+			callback:    commandWhere,
+		},
+		"set": {
+			name:        "set",
+			description: "Change settings: set lang <language>. Localized names can be entered once they have been shown",
+			callback:    commandSet,
+		},
+		"cache": {
+			name:        "cache",
+			description: "Inspect and manage the cache: cache stats|list|clear|evict <key>",
+			callback:    commandCache,
+		},
 	}
 }
 
 type config struct {
-	next    *string
-	prev    *string
-	running bool
-	cache   pokecache.Cache
+	// Position of map and mapb
+	locations locationPager
+	running   bool
+	cache     *pokecache.Cache
+	client    *pokeapi.Client
+	// Language to show text in, as a PokeAPI language name
+	lang string
+	// Localized names seen so far, so that they can be entered instead of slugs
+	names   nameIndex
 	pokeman map[string]pokeapi.PokeapiPokemon
+	items   itemPager
 }
 
-func commandHelp(c *config, _ []string) {
+func commandHelp(_ context.Context, c *config, _ []string) {
+	commands := allCommands()
+	names := make([]string, 0, len(commands))
+	for name := range commands {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
 	fmt.Println("Help menu")
-	// TODO: Expand on this
+	for _, name := range names {
+		fmt.Printf("  %v: %v\n", name, commands[name].description)
+	}
 }
 
-func commandExit(c *config, _ []string) {
+func commandExit(_ context.Context, c *config, _ []string) {
 	c.running = false
 }
 
-func commandMap(c *config, _ []string) {
-	printLocationsPage(c, c.next)
-}
-
-func commandMapb(c *config, _ []string) {
-	printLocationsPage(c, c.prev)
-}
-
-func printLocationsPage(c *config, dest *string) {
-	p, err := pokeapi.GetLocations(dest, c.cache)
-	if err != nil {
-		fmt.Printf("Error: %s", err)
-		return
+// turnPage moves pager to the next page, or the previous one if back is true.
+// Paging past either end starts over from the first page.
+func turnPage[T any](ctx context.Context, pager *pokeapi.Pager[T], back bool) (pokeapi.NamedAPIResourceList[T], error) {
+	turn := pager.Next
+	if back {
+		turn = pager.Prev
 	}
-	locationNames := make([]string, 0, 20)
-	for _, location := range p.Results {
-		locationNames = append(locationNames, location.Name)
-	}
-	c.next = p.Next
-	c.prev = p.Previous
-	for _, n := range locationNames {
-		fmt.Println(n)
-	}
-}
-
-func commandExplore(c *config, args []string) {
-	if len(args) == 0 {
-		fmt.Println("Enter the name of an area to explore it further!")
-		return
-	}
-
-	name := args[0]
-
-	areaDetails, err := pokeapi.GetLocationDetails(name, c.cache)
-	if err != nil {
-		fmt.Printf("Something went wrong while exploring %v\n", name)
-	}
-
-	fmt.Printf("Exploring %v...\n", name)
-	fmt.Println("Found Pokemon:")
-	for _, encounter := range areaDetails.PokemonEncounters {
-		fmt.Printf(" - %v\n", encounter.Pokemon.Name)
+	p, err := turn(ctx)
+	if errors.Is(err, pokeapi.ErrNoMorePages) {
+		pager.Reset()
+		p, err = pager.Next(ctx)
 	}
+	return p, err
 }
 
-func commandCatch(c *config, args []string) {
+func commandCatch(ctx context.Context, c *config, args []string) {
 	if len(args) == 0 {
 		fmt.Println("Enter the name of a Pokemon to try to catch it!")
 		return
 	}
-	pokemon, err := pokeapi.GetPokemonDetails(args[0], c.cache)
-	if err != nil {
-		fmt.Printf("Something went wrong while trying to catch %v.\n", args[0])
+	slug := c.resolveName(ctx, "pokemon-species", args[0])
+	pokemon, err := lookupPokemon(ctx, c, slug)
+	if errors.Is(err, pokeapi.ErrNotFound) {
+		fmt.Printf("There is no Pokemon called %v.\n", args[0])
+		return
+	} else if err != nil {
+		fmt.Printf("Something went wrong while trying to catch %v: %v\n", args[0], err)
 		return
 	}
 
-	name, exp := pokemon.Name, pokemon.BaseExperience
+	name, exp := pokemonName(ctx, c, pokemon.Name), pokemon.BaseExperience
 	// TODO: Mewtwo has base experience 340, change the rng?
 	if exp > 200 {
 		fmt.Printf("%v has base experience %v\n", name, exp)
@@ -176,23 +309,32 @@ func commandCatch(c *config, args []string) {
 	roll := rand.Intn(201)
 	if roll >= exp {
 		fmt.Printf("You caught a %v!\n", name)
-		c.pokeman[name] = pokemon
+		c.pokeman[pokemon.Name] = pokemon
 	} else {
 		fmt.Printf("%v got away!\n", name)
 	}
 }
 
-func commandInspect(c *config, args []string) {
+func commandInspect(ctx context.Context, c *config, args []string) {
 	if len(args) == 0 {
 		fmt.Println("Enter the name of a Pokemon to try to inspect")
 		return
 	}
-	name := args[0]
-	pokemon, exists := c.pokeman[name]
+	slug := c.resolveName(ctx, "pokemon-species", args[0])
+	pokemon, exists := c.pokeman[slug]
+	if !exists {
+		// Pokemon are caught by the name of their default variety, like deoxys-normal for deoxys
+		for _, p := range c.pokeman {
+			if p.Species.Name == slug {
+				pokemon, exists = p, true
+			}
+		}
+	}
 	if !exists {
-		fmt.Printf("You have not caught a %v\n", name)
+		fmt.Printf("You have not caught a %v\n", args[0])
+		return
 	}
-	fmt.Printf("Name: %v\n", pokemon.Name)
+	fmt.Printf("Name: %v\n", pokemonName(ctx, c, pokemon.Name))
 	fmt.Printf("Height: %v\n", pokemon.Height)
 	fmt.Printf("Weight: %v\n", pokemon.Weight)
 	fmt.Printf("Stats:\n")
@@ -201,13 +343,31 @@ func commandInspect(c *config, args []string) {
 	}
 	fmt.Printf("Types:\n")
 	for _, t := range pokemon.Types {
-		fmt.Printf("  - %v\n", t.Type.Name)
+		fmt.Printf("  - %v\n", typeName(ctx, c, t.Type))
+	}
+	fmt.Printf("Abilities:\n")
+	for _, a := range pokemon.Abilities {
+		line := a.Ability.Name
+		// The name and effect are a nice to have, so leave them out if they can't be fetched
+		ability, err := c.client.GetAbility(ctx, a.Ability.Name)
+		if err == nil {
+			line = c.localize("ability", ability.Name, ability.Names)
+		}
+		if a.IsHidden {
+			line += " (hidden)"
+		}
+		if err == nil {
+			if effect := abilityEffect(ability, c.lang, true); effect != "" {
+				line += ": " + effect
+			}
+		}
+		fmt.Printf("  - %v\n", line)
 	}
 }
 
-func commandPokedex(c *config, args []string) {
+func commandPokedex(_ context.Context, c *config, args []string) {
 	fmt.Println("Your Pokedex:")
 	for k, _ := range c.pokeman {
-		fmt.Printf("  - %v\n", k)
+		fmt.Printf("  - %v\n", c.knownName("pokemon-species", k))
 	}
 }
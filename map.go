@@ -0,0 +1,157 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/madsbv/pokerepl/internal/pokeapi"
+)
+
+// Number of location areas shown per page by map, unless changed with --limit
+const defaultLocationPageSize = 20
+
+// locationPager tracks the position of map and mapb in the list of location areas.
+type locationPager struct {
+	// Index of the page shown last, or -1 before the first page
+	page  int
+	limit int
+	// Total number of location areas, or 0 until the first page has been fetched
+	count int
+}
+
+func newLocationPager() locationPager {
+	return locationPager{page: -1, limit: defaultLocationPageSize}
+}
+
+// pages returns the number of pages, or 0 if it is not known yet.
+func (p *locationPager) pages() int {
+	return (p.count + p.limit - 1) / p.limit
+}
+
+func commandMap(ctx context.Context, c *config, args []string) {
+	args, flags, err := parseFlags(args, "limit")
+	if err != nil {
+		fmt.Println(err)
+		return
+	}
+	p := &c.locations
+
+	limit := p.limit
+	if l, ok := flags["limit"]; ok {
+		limit, err = strconv.Atoi(l)
+		if err != nil || limit <= 0 {
+			fmt.Printf("The page size must be a positive number, not %v\n", l)
+			return
+		}
+	}
+	n := 0
+	if len(args) > 0 && args[0] != "first" && args[0] != "last" {
+		n, err = strconv.Atoi(args[0])
+		if err != nil || n <= 0 {
+			fmt.Println("Usage: map [<page>|first|last] [--limit <page size>]")
+			return
+		}
+	}
+
+	if p.setLimit(limit) && len(args) == 0 {
+		printLocationsPage(ctx, c, max(p.page, 0))
+		return
+	}
+
+	switch {
+	case len(args) == 0:
+		printLocationsPage(ctx, c, p.page+1)
+	case args[0] == "first":
+		printLocationsPage(ctx, c, 0)
+	case args[0] == "last":
+		last, err := p.lastPage(ctx, c)
+		if err != nil {
+			fmt.Printf("Error: %s\n", err)
+			return
+		}
+		printLocationsPage(ctx, c, last)
+	default:
+		if err := p.checkPage(n - 1); err != nil {
+			fmt.Println(err)
+			return
+		}
+		printLocationsPage(ctx, c, n-1)
+	}
+}
+
+// setLimit changes the page size to limit, staying on the page containing the
+// first location area shown last. It reports whether the page size changed.
+func (p *locationPager) setLimit(limit int) bool {
+	if limit == p.limit {
+		return false
+	}
+	if p.page >= 0 {
+		p.page = p.page * p.limit / limit
+	}
+	p.limit = limit
+	return true
+}
+
+// wrap returns page, or the first page if page is past either end.
+func (p *locationPager) wrap(page int) int {
+	if page < 0 || (p.count > 0 && page >= p.pages()) {
+		return 0
+	}
+	return page
+}
+
+// checkPage returns an error if page, counting from 0, is known not to exist.
+func (p *locationPager) checkPage(page int) error {
+	if p.count > 0 && page >= p.pages() {
+		return fmt.Errorf("There is no page %v, there are only %v pages", page+1, p.pages())
+	}
+	return nil
+}
+
+// lastPage returns the last page, counting from 0. If the number of location
+// areas isn't known yet, it is found out by fetching the first page.
+func (p *locationPager) lastPage(ctx context.Context, c *config) (int, error) {
+	if p.count == 0 {
+		if _, err := p.fetch(ctx, c, 0); err != nil {
+			return 0, err
+		}
+	}
+	return max(p.pages()-1, 0), nil
+}
+
+func commandMapb(ctx context.Context, c *config, _ []string) {
+	printLocationsPage(ctx, c, c.locations.page-1)
+}
+
+// printLocationsPage prints the location areas on page, counting from 0.
+// Paging past either end starts over from the first page.
+func printLocationsPage(ctx context.Context, c *config, page int) {
+	p := &c.locations
+	page = p.wrap(page)
+	list, err := p.fetch(ctx, c, page)
+	if err != nil {
+		fmt.Printf("Error: %s\n", err)
+		return
+	}
+	// Only now is the number of pages known for sure
+	if err := p.checkPage(page); err != nil {
+		fmt.Println(err)
+		return
+	}
+	for _, location := range list.Results {
+		fmt.Println(listedAreaName(c, location))
+	}
+	p.page = page
+	fmt.Printf("Page %v of %v\n", page+1, p.pages())
+}
+
+// fetch fetches page without moving to it, updating the total count.
+func (p *locationPager) fetch(ctx context.Context, c *config, page int) (pokeapi.NamedAPIResourceList[pokeapi.PokeapiLocation], error) {
+	list, err := pokeapi.FetchPage[pokeapi.PokeapiLocation](ctx, c.client, "location-area", p.limit, page*p.limit)
+	if err != nil {
+		return list, err
+	}
+	p.count = list.Count
+	return list, nil
+}
@@ -0,0 +1,115 @@
+package main
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/madsbv/pokerepl/internal/pokeapi"
+	"github.com/madsbv/pokerepl/internal/pokecache"
+)
+
+func TestLocationPagerSetLimit(t *testing.T) {
+	tests := []struct {
+		page, limit int
+		newLimit    int
+		wantPage    int
+		wantChanged bool
+	}{
+		{page: 2, limit: 20, newLimit: 20, wantPage: 2, wantChanged: false},
+		// Page 3 starts at area 40, which is on page 5 of 10 areas
+		{page: 2, limit: 20, newLimit: 10, wantPage: 4, wantChanged: true},
+		// Area 40 is on page 1 of 30 areas
+		{page: 2, limit: 20, newLimit: 30, wantPage: 1, wantChanged: true},
+		{page: 0, limit: 20, newLimit: 5, wantPage: 0, wantChanged: true},
+		// Before the first page, the next page is still the first one
+		{page: -1, limit: 20, newLimit: 5, wantPage: -1, wantChanged: true},
+	}
+	for _, tt := range tests {
+		p := locationPager{page: tt.page, limit: tt.limit}
+		changed := p.setLimit(tt.newLimit)
+		if changed != tt.wantChanged || p.page != tt.wantPage || p.limit != tt.newLimit {
+			t.Errorf(`Page %v of size %v: setLimit(%v) = %v, moving to page %v of size %v, want %v, page %v.`,
+				tt.page, tt.limit, tt.newLimit, changed, p.page, p.limit, tt.wantChanged, tt.wantPage)
+		}
+	}
+}
+
+func TestLocationPagerWrap(t *testing.T) {
+	tests := []struct {
+		count int
+		page  int
+		want  int
+	}{
+		{count: 45, page: 1, want: 1},
+		{count: 45, page: 2, want: 2},
+		{count: 45, page: 3, want: 0},
+		{count: 45, page: -1, want: 0},
+		// Without a count, paging forwards can't wrap yet
+		{count: 0, page: 5, want: 5},
+		{count: 0, page: -1, want: 0},
+	}
+	for _, tt := range tests {
+		p := locationPager{limit: 20, count: tt.count}
+		if got := p.wrap(tt.page); got != tt.want {
+			t.Errorf(`With %v areas: wrap(%v) = %v, want %v.`, tt.count, tt.page, got, tt.want)
+		}
+	}
+}
+
+func TestLocationPagerCheckPage(t *testing.T) {
+	tests := []struct {
+		count   int
+		page    int
+		wantErr string
+	}{
+		{count: 45, page: 0},
+		{count: 45, page: 2},
+		{count: 45, page: 3, wantErr: "There is no page 4, there are only 3 pages"},
+		{count: 40, page: 2, wantErr: "There is no page 3, there are only 2 pages"},
+		// Without a count, any page might exist
+		{count: 0, page: 100},
+	}
+	for _, tt := range tests {
+		p := locationPager{limit: 20, count: tt.count}
+		err := p.checkPage(tt.page)
+		if (err == nil && tt.wantErr != "") || (err != nil && err.Error() != tt.wantErr) {
+			t.Errorf(`With %v areas: checkPage(%v) = %v, want %q.`, tt.count, tt.page, err, tt.wantErr)
+		}
+	}
+}
+
+func TestLocationPagerLastPage(t *testing.T) {
+	var requests atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests.Add(1)
+		if r.URL.Path != "/api/v2/location-area/" || r.URL.Query().Get("offset") != "0" {
+			t.Errorf("Got request for %v, want the first page of /api/v2/location-area/.", r.URL)
+		}
+		w.Write([]byte(`{"count": 45, "results": []}`))
+	}))
+	defer server.Close()
+	cache := pokecache.New(time.Minute)
+	defer cache.Close()
+	c := &config{client: pokeapi.NewClient(cache, pokeapi.WithBaseURL(server.URL+"/api/v2"), pokeapi.WithHTTPClient(server.Client()))}
+
+	p := newLocationPager()
+	last, err := p.lastPage(context.Background(), c)
+	if err != nil || last != 2 {
+		t.Fatalf(`lastPage() = %v, %v before the count is known, want 2, nil.`, last, err)
+	}
+	if p.page != -1 {
+		t.Fatalf(`lastPage() moved to page %v, but it should only fetch the count.`, p.page)
+	}
+
+	p.setLimit(10)
+	if last, err := p.lastPage(context.Background(), c); err != nil || last != 4 {
+		t.Fatalf(`lastPage() = %v, %v with pages of 10, want 4, nil.`, last, err)
+	}
+	if n := requests.Load(); n != 1 {
+		t.Fatalf(`Sent %v requests, but the count should only be fetched once.`, n)
+	}
+}
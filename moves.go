@@ -0,0 +1,171 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/madsbv/pokerepl/internal/pokeapi"
+)
+
+func commandMove(ctx context.Context, c *config, args []string) {
+	if len(args) == 0 {
+		fmt.Println("Enter the name of a move to look it up")
+		return
+	}
+	name := c.resolveName(ctx, "move", args[0])
+
+	move, err := c.client.GetMove(ctx, name)
+	if errors.Is(err, pokeapi.ErrNotFound) {
+		fmt.Printf("There is no move called %v\n", name)
+		return
+	} else if err != nil {
+		fmt.Printf("Something went wrong while looking up %v: %v\n", name, err)
+		return
+	}
+
+	fmt.Printf("Name: %v\n", c.localize("move", move.Name, move.Names))
+	fmt.Printf("Type: %v\n", typeName(ctx, c, move.Type))
+	fmt.Printf("Damage class: %v\n", move.DamageClass.Name)
+	fmt.Printf("Power: %v\n", optionalStat(move.Power))
+	fmt.Printf("Accuracy: %v\n", optionalStat(move.Accuracy))
+	fmt.Printf("PP: %v\n", move.Pp)
+	fmt.Printf("Priority: %v\n", move.Priority)
+	if effect := moveEffect(move, c.lang); effect != "" {
+		fmt.Printf("Effect: %v\n", effect)
+	}
+	if ailment := move.Meta.Ailment.Name; ailment != "" && ailment != "none" {
+		fmt.Printf("Ailment: %v (%v%% chance)\n", ailment, move.Meta.AilmentChance)
+	}
+	if move.Meta.FlinchChance > 0 {
+		fmt.Printf("Flinch chance: %v%%\n", move.Meta.FlinchChance)
+	}
+	if move.Meta.CritRate > 0 {
+		fmt.Printf("Critical hit stage: +%v\n", move.Meta.CritRate)
+	}
+	if move.Meta.MaxHits > 0 {
+		fmt.Printf("Hits: %v-%v\n", move.Meta.MinHits, move.Meta.MaxHits)
+	}
+	if move.Meta.Drain != 0 {
+		fmt.Printf("Drain: %v%%\n", move.Meta.Drain)
+	}
+	if move.Meta.Healing != 0 {
+		fmt.Printf("Healing: %v%%\n", move.Meta.Healing)
+	}
+}
+
+// optionalStat formats stats that some moves don't have, like the power of status moves.
+func optionalStat(stat *int) string {
+	if stat == nil {
+		return "-"
+	}
+	return strconv.Itoa(*stat)
+}
+
+// moveEffect returns the short effect text of move in lang, falling back to fallbackLang.
+func moveEffect(move pokeapi.PokeapiMove, lang string) string {
+	for _, l := range []string{lang, fallbackLang} {
+		for _, e := range move.EffectEntries {
+			if e.Language.Name != l {
+				continue
+			}
+			effect := e.ShortEffect
+			if move.EffectChance != nil {
+				effect = strings.ReplaceAll(effect, "$effect_chance", strconv.Itoa(*move.EffectChance))
+			}
+			return effect
+		}
+	}
+	return ""
+}
+
+// A learnedMove is a move in the learnset of a Pokemon.
+type learnedMove struct {
+	move  pokeapi.NamedAPIResource[pokeapi.PokeapiMove]
+	level int
+}
+
+func commandMoves(ctx context.Context, c *config, args []string) {
+	if len(args) == 0 {
+		fmt.Println("Enter the name of a Pokemon, and optionally a version group like red-blue, to list its moves")
+		return
+	}
+	name := c.resolveName(ctx, "pokemon-species", args[0])
+
+	pokemon, err := lookupPokemon(ctx, c, name)
+	if errors.Is(err, pokeapi.ErrNotFound) {
+		fmt.Printf("There is no Pokemon called %v\n", name)
+		return
+	} else if err != nil {
+		fmt.Printf("Something went wrong while looking up %v: %v\n", name, err)
+		return
+	}
+
+	versionGroup := latestVersionGroup(pokemon)
+	if len(args) > 1 {
+		versionGroup = args[1]
+	}
+
+	byMethod := make(map[string][]learnedMove)
+	for _, m := range pokemon.Moves {
+		for _, d := range m.VersionGroupDetails {
+			if d.VersionGroup.Name != versionGroup {
+				continue
+			}
+			method := d.MoveLearnMethod.Name
+			byMethod[method] = append(byMethod[method], learnedMove{m.Move, d.LevelLearnedAt})
+		}
+	}
+	if len(byMethod) == 0 {
+		fmt.Printf("%v learns no moves in %v\n", pokemonName(ctx, c, pokemon.Name), versionGroup)
+		return
+	}
+
+	methods := make([]string, 0, len(byMethod))
+	for method := range byMethod {
+		methods = append(methods, method)
+	}
+	// Level-up moves are the most interesting, so list them first
+	sort.Slice(methods, func(i, j int) bool {
+		if (methods[i] == "level-up") != (methods[j] == "level-up") {
+			return methods[i] == "level-up"
+		}
+		return methods[i] < methods[j]
+	})
+
+	fmt.Printf("Moves of %v in %v:\n", pokemonName(ctx, c, pokemon.Name), versionGroup)
+	for _, method := range methods {
+		moves := byMethod[method]
+		sort.Slice(moves, func(i, j int) bool {
+			if moves[i].level != moves[j].level {
+				return moves[i].level < moves[j].level
+			}
+			return moves[i].move.Name < moves[j].move.Name
+		})
+		fmt.Printf("%v:\n", method)
+		for _, m := range moves {
+			if method == "level-up" {
+				fmt.Printf("  - %v (level %v)\n", listedMoveName(c, m.move), m.level)
+			} else {
+				fmt.Printf("  - %v\n", listedMoveName(c, m.move))
+			}
+		}
+	}
+}
+
+// latestVersionGroup returns the most recent version group in which pokemon learns any moves.
+// Version groups are numbered in release order, so the most recent one has the highest ID.
+func latestVersionGroup(pokemon pokeapi.PokeapiPokemon) string {
+	latest, latestID := "", -1
+	for _, m := range pokemon.Moves {
+		for _, d := range m.VersionGroupDetails {
+			if id := resourceID(d.VersionGroup.URL); id > latestID {
+				latest, latestID = d.VersionGroup.Name, id
+			}
+		}
+	}
+	return latest
+}
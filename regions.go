@@ -0,0 +1,98 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/madsbv/pokerepl/internal/pokeapi"
+)
+
+func commandRegions(ctx context.Context, c *config, args []string) {
+	regions, err := pokeapi.ListAll[pokeapi.PokeapiRegion](ctx, c.client, "region", 0)
+	if err != nil {
+		fmt.Printf("Something went wrong while listing regions: %v\n", err)
+		return
+	}
+	for _, r := range regions {
+		fmt.Println(listedName(c, "region", r, func(r pokeapi.PokeapiRegion) []pokeapi.Name {
+			return r.Names
+		}))
+	}
+}
+
+func commandRegion(ctx context.Context, c *config, args []string) {
+	if len(args) == 0 {
+		fmt.Println("Enter the name of a region to list its locations")
+		return
+	}
+	name := c.resolveName(ctx, "region", args[0])
+
+	region, err := c.client.GetRegion(ctx, name)
+	if errors.Is(err, pokeapi.ErrNotFound) {
+		fmt.Printf("There is no region called %v\n", name)
+		return
+	} else if err != nil {
+		fmt.Printf("Something went wrong while looking up %v: %v\n", name, err)
+		return
+	}
+
+	fmt.Printf("Name: %v\n", c.localize("region", region.Name, region.Names))
+	if region.MainGeneration.Name != "" {
+		fmt.Printf("Introduced in: %v\n", generationName(ctx, c, region.MainGeneration.Name))
+	}
+	if len(region.VersionGroups) > 0 {
+		games := make([]string, 0, len(region.VersionGroups))
+		for _, g := range region.VersionGroups {
+			games = append(games, g.Name)
+		}
+		fmt.Printf("Games: %v\n", strings.Join(games, ", "))
+	}
+	fmt.Println("Locations:")
+	for _, l := range region.Locations {
+		fmt.Printf("  - %v\n", listedPlaceName(c, l))
+	}
+}
+
+func commandLocation(ctx context.Context, c *config, args []string) {
+	if len(args) == 0 {
+		fmt.Println("Enter the name of a location to list its areas")
+		return
+	}
+	name := c.resolveName(ctx, "location", args[0])
+
+	location, err := c.client.GetPlace(ctx, name)
+	if errors.Is(err, pokeapi.ErrNotFound) {
+		fmt.Printf("There is no location called %v\n", name)
+		return
+	} else if err != nil {
+		fmt.Printf("Something went wrong while looking up %v: %v\n", name, err)
+		return
+	}
+
+	fmt.Printf("Name: %v\n", c.localize("location", location.Name, location.Names))
+	if location.Region.Name != "" {
+		fmt.Printf("Region: %v\n", regionName(ctx, c, location.Region))
+	}
+	if len(location.Areas) == 0 {
+		fmt.Println("There are no areas to explore here")
+		return
+	}
+	fmt.Println("Areas:")
+	for _, a := range location.Areas {
+		fmt.Printf("  - %v\n", listedAreaName(c, a))
+	}
+}
+
+// generationName returns the name of the generation called slug in the configured language.
+func generationName(ctx context.Context, c *config, slug string) string {
+	if c.lang == fallbackLang {
+		return slug
+	}
+	generation, err := c.client.GetGeneration(ctx, slug)
+	if err != nil {
+		return slug
+	}
+	return c.localize("generation", generation.Name, generation.Names)
+}
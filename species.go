@@ -0,0 +1,117 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/madsbv/pokerepl/internal/pokeapi"
+)
+
+func commandSpecies(ctx context.Context, c *config, args []string) {
+	if len(args) == 0 {
+		fmt.Println("Enter the name of a Pokemon species to look it up")
+		return
+	}
+	name := c.resolveName(ctx, "pokemon-species", args[0])
+
+	species, err := lookupSpecies(ctx, c, name)
+	if errors.Is(err, pokeapi.ErrNotFound) {
+		fmt.Printf("There is no Pokemon species called %v\n", name)
+		return
+	} else if err != nil {
+		fmt.Printf("Something went wrong while looking up %v: %v\n", name, err)
+		return
+	}
+
+	fmt.Printf("Name: %v\n", c.localize("pokemon-species", species.Name, species.Names))
+	if genus := speciesGenus(species, c.lang); genus != "" {
+		fmt.Printf("Genus: %v\n", genus)
+	}
+	if text := speciesFlavorText(species, c.lang); text != "" {
+		fmt.Printf("%v\n", text)
+	}
+	fmt.Printf("Capture rate: %v\n", species.CaptureRate)
+	fmt.Printf("Base happiness: %v\n", species.BaseHappiness)
+	fmt.Printf("Growth rate: %v\n", species.GrowthRate.Name)
+	if species.IsLegendary {
+		fmt.Println("Legendary")
+	}
+	if species.IsMythical {
+		fmt.Println("Mythical")
+	}
+	if len(species.Varieties) > 1 {
+		fmt.Println("Varieties:")
+		for _, v := range species.Varieties {
+			fmt.Printf("  - %v\n", c.knownName("pokemon-species", v.Pokemon.Name))
+		}
+	}
+}
+
+// lookupSpecies fetches the species called name. Since many Pokemon, like
+// deoxys-attack, are varieties of a species with another name, it falls back
+// to looking up the species of the Pokemon called name.
+func lookupSpecies(ctx context.Context, c *config, name string) (pokeapi.PokeapiSpecies, error) {
+	species, err := c.client.GetSpecies(ctx, name)
+	if !errors.Is(err, pokeapi.ErrNotFound) {
+		return species, err
+	}
+	pokemon, pokemonErr := c.client.GetPokemonDetails(ctx, name)
+	if pokemonErr != nil {
+		return species, err
+	}
+	return pokemon.Species.Resolve(ctx, c.client)
+}
+
+// lookupPokemon fetches the Pokemon called name. Since the default Pokemon of
+// some species, like deoxys-normal for deoxys, have another name, it falls
+// back to the default variety of the species called name.
+func lookupPokemon(ctx context.Context, c *config, name string) (pokeapi.PokeapiPokemon, error) {
+	pokemon, err := c.client.GetPokemonDetails(ctx, name)
+	if !errors.Is(err, pokeapi.ErrNotFound) {
+		return pokemon, err
+	}
+	species, speciesErr := c.client.GetSpecies(ctx, name)
+	if speciesErr != nil {
+		return pokemon, err
+	}
+	for _, v := range species.Varieties {
+		if v.IsDefault {
+			return v.Pokemon.Resolve(ctx, c.client)
+		}
+	}
+	return pokemon, err
+}
+
+// speciesFlavorText returns the most recent flavor text of species in lang, falling back to fallbackLang.
+func speciesFlavorText(species pokeapi.PokeapiSpecies, lang string) string {
+	for _, l := range []string{lang, fallbackLang} {
+		// Entries are ordered by game, so the last one is the most recent
+		for i := len(species.FlavorTextEntries) - 1; i >= 0; i-- {
+			entry := species.FlavorTextEntries[i]
+			if entry.Language.Name == l {
+				return cleanFlavorText(entry.FlavorText)
+			}
+		}
+	}
+	return ""
+}
+
+func speciesGenus(species pokeapi.PokeapiSpecies, lang string) string {
+	for _, l := range []string{lang, fallbackLang} {
+		for _, g := range species.Genera {
+			if g.Language.Name == l {
+				return g.Genus
+			}
+		}
+	}
+	return ""
+}
+
+// Flavor texts are formatted for the small screens of the games, with hard
+// line breaks, form feeds and soft hyphens that would look odd in a terminal.
+func cleanFlavorText(text string) string {
+	text = strings.ReplaceAll(text, "\u00ad\n", "")
+	return strings.Join(strings.Fields(text), " ")
+}
@@ -0,0 +1,46 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/madsbv/pokerepl/internal/pokeapi"
+	"github.com/madsbv/pokerepl/internal/typechart"
+)
+
+func commandWeakness(ctx context.Context, c *config, args []string) {
+	if len(args) == 0 {
+		fmt.Println("Enter the name of a Pokemon to see its weaknesses")
+		return
+	}
+	name := c.resolveName(ctx, "pokemon-species", args[0])
+
+	pokemon, err := lookupPokemon(ctx, c, name)
+	if errors.Is(err, pokeapi.ErrNotFound) {
+		fmt.Printf("There is no Pokemon called %v\n", name)
+		return
+	} else if err != nil {
+		fmt.Printf("Something went wrong while looking up %v: %v\n", name, err)
+		return
+	}
+
+	types := make([]pokeapi.PokeapiType, 0, len(pokemon.Types))
+	typeNames := make([]string, 0, len(pokemon.Types))
+	for _, t := range pokemon.Types {
+		pt, err := c.client.GetType(ctx, t.Type.Name)
+		if err != nil {
+			fmt.Printf("Something went wrong while looking up the %v type: %v\n", t.Type.Name, err)
+			return
+		}
+		types = append(types, pt)
+		typeNames = append(typeNames, c.localize("type", pt.Name, pt.Names))
+	}
+
+	fmt.Printf("%v (%v) takes:\n", pokemonName(ctx, c, pokemon.Name), strings.Join(typeNames, "/"))
+	for _, g := range typechart.Groups(typechart.Multipliers(types)) {
+		fmt.Printf("  %vx: %v\n", strconv.FormatFloat(g.Multiplier, 'f', -1, 64), strings.Join(g.Types, ", "))
+	}
+}